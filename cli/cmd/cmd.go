@@ -37,9 +37,6 @@ func main() {
 	time.Sleep(time.Second * 3)
 
 	// mic stuf
-	sig := make(chan os.Signal, 1)
-	signal.Notify(sig, os.Interrupt, os.Kill)
-
 	mic, err := microphone.Initialize(microphone.AudioConfig{
 		InputChannels: 1,
 		SamplingRate:  16000,
@@ -56,8 +53,10 @@ func main() {
 		os.Exit(1)
 	}
 
-	// this is a blocking call
-	mic.Stream(client)
+	// this is a blocking call until Ctrl+C
+	streamCtx, stop := signal.NotifyContext(ctx, os.Interrupt, os.Kill)
+	mic.Stream(streamCtx, client)
+	stop()
 
 	// close stream
 	err = mic.Stop()
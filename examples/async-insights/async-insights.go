@@ -0,0 +1,67 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/davecgh/go-spew/spew"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	symbl "github.com/dvonthenen/symbl-go-sdk/pkg/client"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelStandard,
+	})
+
+	/*
+		------------------------------------
+		async (process and fetch insights)
+		------------------------------------
+	*/
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	asyncClient := async.New(restClient)
+
+	insights, err := asyncClient.ProcessConversation(ctx, async.ProcessRequest{
+		Options: interfaces.AsyncOptions{
+			URL: "https://symbltestdata.s3.us-east-2.amazonaws.com/newPhonecall.mp3",
+		},
+		Insights: []string{
+			async.InsightMessages,
+			async.InsightTopics,
+			async.InsightQuestions,
+			async.InsightActionItems,
+			async.InsightFollowUps,
+			async.InsightSummary,
+			async.InsightAnalytics,
+		},
+	})
+	if err == async.ErrPartialInsights {
+		for insight, e := range insights.Errors {
+			fmt.Printf("%s failed. Err: %v\n", insight, e)
+		}
+	} else if err != nil {
+		fmt.Printf("ProcessConversation failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("JobID: %s, ConversationID: %s\n\n", insights.JobID, insights.ConversationID)
+	spew.Dump(insights)
+
+	fmt.Printf("Succeeded")
+}
@@ -9,7 +9,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/signal"
 	"time"
 
 	streaming "github.com/dvonthenen/symbl-go-sdk/pkg/api/streaming/v1"
@@ -56,9 +55,6 @@ func main() {
 	time.Sleep(time.Second * 2)
 
 	// mic stuf
-	sig := make(chan os.Signal, 1)
-	signal.Notify(sig, os.Interrupt, os.Kill)
-
 	mic, err := microphone.Initialize(microphone.AudioConfig{
 		InputChannels: 1,
 		SamplingRate:  16000,
@@ -75,14 +71,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	streamCtx, cancel := context.WithCancel(ctx)
 	go func() {
 		// this is a blocking call
-		mic.Stream(client)
+		mic.Stream(streamCtx, client)
 	}()
 
 	fmt.Print("Press ENTER to exit!\n\n")
 	input := bufio.NewScanner(os.Stdin)
 	input.Scan()
+	cancel()
 
 	// close stream
 	err = mic.Stop()
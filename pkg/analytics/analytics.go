@@ -0,0 +1,259 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package analytics
+
+import (
+	"sort"
+	"time"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+type interval struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func calculate(utterances []Utterance, options Options) *Result {
+	result := &Result{}
+	if len(utterances) == 0 {
+		return result
+	}
+
+	sort.SliceStable(utterances, func(i, j int) bool {
+		return utterances[i].Start < utterances[j].Start
+	})
+
+	// conversation span
+	start := utterances[0].Start
+	end := start
+	for _, u := range utterances {
+		if u.End > end {
+			end = u.End
+		}
+	}
+	result.Duration = end - start
+
+	// speakers in order of appearance
+	speakers := make(map[string]*SpeakerStats)
+	order := make([]string, 0)
+	intervals := make(map[string][]interval)
+	all := make([]interval, 0, len(utterances))
+	for _, u := range utterances {
+		stats, ok := speakers[u.SpeakerID]
+		if !ok {
+			stats = &SpeakerStats{
+				ID: u.SpeakerID,
+			}
+			speakers[u.SpeakerID] = stats
+			order = append(order, u.SpeakerID)
+		}
+		if len(stats.Name) == 0 {
+			stats.Name = u.SpeakerName
+		}
+		stats.Words += u.Words
+
+		intervals[u.SpeakerID] = append(intervals[u.SpeakerID], interval{u.Start, u.End})
+		all = append(all, interval{u.Start, u.End})
+	}
+
+	for _, id := range order {
+		stats := speakers[id]
+		stats.TalkTime = unionLength(intervals[id])
+		stats.ListenTime = result.Duration - stats.TalkTime
+		if stats.TalkTime > 0 {
+			stats.WordsPerMinute = float64(stats.Words) / stats.TalkTime.Minutes()
+		}
+	}
+
+	// overlaps and interruptions
+	overlapWith := make(map[string]map[string]time.Duration)
+	overlapIntervals := make([]interval, 0)
+	for i := range utterances {
+		first := utterances[i]
+		for j := i + 1; j < len(utterances) && utterances[j].Start < first.End; j++ {
+			second := utterances[j]
+			if second.SpeakerID == first.SpeakerID {
+				continue
+			}
+
+			overlapEnd := first.End
+			if second.End < overlapEnd {
+				overlapEnd = second.End
+			}
+			duration := overlapEnd - second.Start
+			if duration <= 0 {
+				continue
+			}
+
+			// the second speaker cut in and the first stopped before the second did
+			interruption := second.Start > first.Start && duration >= options.InterruptionThreshold && first.End <= second.End
+			if interruption {
+				speakers[second.SpeakerID].Interruptions++
+				speakers[first.SpeakerID].Interrupted++
+			}
+
+			result.Overlaps = append(result.Overlaps, OverlapEvent{
+				FirstSpeakerID:  first.SpeakerID,
+				SecondSpeakerID: second.SpeakerID,
+				Start:           second.Start,
+				Duration:        duration,
+				Interruption:    interruption,
+			})
+			overlapIntervals = append(overlapIntervals, interval{second.Start, overlapEnd})
+
+			for _, pair := range [][2]string{{first.SpeakerID, second.SpeakerID}, {second.SpeakerID, first.SpeakerID}} {
+				if overlapWith[pair[0]] == nil {
+					overlapWith[pair[0]] = make(map[string]time.Duration)
+				}
+				overlapWith[pair[0]][pair[1]] += duration
+			}
+		}
+	}
+
+	// silence between speech
+	speechEnd := utterances[0].End
+	for _, u := range utterances[1:] {
+		if gap := u.Start - speechEnd; gap >= options.SilenceThreshold {
+			result.Silences = append(result.Silences, Gap{
+				Start:    speechEnd,
+				Duration: gap,
+			})
+		}
+		if u.End > speechEnd {
+			speechEnd = u.End
+		}
+	}
+
+	// monologues are consecutive utterances by the same speaker without a long silence
+	var current *Monologue
+	var currentEnd time.Duration
+	closeMonologue := func() {
+		if current == nil {
+			return
+		}
+		stats := speakers[current.SpeakerID]
+		if stats.LongestMonologue == nil || current.Duration > stats.LongestMonologue.Duration {
+			stats.LongestMonologue = current
+		}
+		if result.LongestMonologue == nil || current.Duration > result.LongestMonologue.Duration {
+			result.LongestMonologue = current
+		}
+	}
+	for _, u := range utterances {
+		if current != nil && current.SpeakerID == u.SpeakerID && u.Start-currentEnd < options.SilenceThreshold {
+			if u.End > currentEnd {
+				currentEnd = u.End
+			}
+			current.Duration = currentEnd - current.Start
+			current.Words += u.Words
+			continue
+		}
+
+		closeMonologue()
+		current = &Monologue{
+			SpeakerID: u.SpeakerID,
+			Start:     u.Start,
+			Duration:  u.End - u.Start,
+			Words:     u.Words,
+		}
+		currentEnd = u.End
+	}
+	closeMonologue()
+
+	// platform shaped results
+	talkTime := unionLength(all)
+	overlapTime := unionLength(overlapIntervals)
+	silence := result.Duration - talkTime
+
+	result.Metrics = []interfaces.Metric{
+		newMetric(MetricTotalSilence, silence, result.Duration),
+		newMetric(MetricTotalTalkTime, talkTime, result.Duration),
+		newMetric(MetricTotalOverlap, overlapTime, result.Duration),
+	}
+
+	for _, id := range order {
+		stats := speakers[id]
+		for _, other := range overlapWith[id] {
+			stats.OverlapTime += other
+		}
+		result.Speakers = append(result.Speakers, *stats)
+		result.Members = append(result.Members, toMember(stats, result.Duration, overlapWith[id], speakers, order))
+	}
+
+	return result
+}
+
+func toMember(stats *SpeakerStats, total time.Duration, overlaps map[string]time.Duration, speakers map[string]*SpeakerStats, order []string) interfaces.Member {
+	member := interfaces.Member{
+		ID:   stats.ID,
+		Name: stats.Name,
+	}
+	member.Pace.Wpm = int(stats.WordsPerMinute + 0.5)
+	member.TalkTime.Seconds = stats.TalkTime.Seconds()
+	member.TalkTime.Percentage = percentage(stats.TalkTime, total)
+	member.ListenTime.Seconds = stats.ListenTime.Seconds()
+	member.ListenTime.Percentage = percentage(stats.ListenTime, total)
+	member.Overlap.Seconds = stats.OverlapTime.Seconds()
+	member.Overlap.Percentage = percentage(stats.OverlapTime, stats.TalkTime)
+
+	for _, id := range order {
+		duration, ok := overlaps[id]
+		if !ok {
+			continue
+		}
+		member.Overlap.OverlappingMembers = append(member.Overlap.OverlappingMembers, interfaces.OverlappingMember{
+			ID:         id,
+			Name:       speakers[id].Name,
+			Seconds:    duration.Seconds(),
+			Percentage: percentage(duration, stats.TalkTime),
+		})
+	}
+
+	return member
+}
+
+func newMetric(metricType string, value, total time.Duration) interfaces.Metric {
+	return interfaces.Metric{
+		Type:    metricType,
+		Seconds: value.Seconds(),
+		Percent: percentage(value, total),
+	}
+}
+
+func percentage(value, total time.Duration) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(value) / float64(total) * 100
+}
+
+// unionLength is the total time covered by the intervals, counting overlapping time once
+func unionLength(intervals []interval) time.Duration {
+	if len(intervals) == 0 {
+		return 0
+	}
+
+	sorted := make([]interval, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].start < sorted[j].start
+	})
+
+	var total time.Duration
+	current := sorted[0]
+	for _, next := range sorted[1:] {
+		if next.start <= current.end {
+			if next.end > current.end {
+				current.end = next.end
+			}
+			continue
+		}
+		total += current.end - current.start
+		current = next
+	}
+	total += current.end - current.start
+
+	return total
+}
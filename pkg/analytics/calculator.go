@@ -0,0 +1,168 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package analytics
+
+import (
+	"strings"
+	"time"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+	streaminginterfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/streaming/v1/interfaces"
+)
+
+// Calculate derives analytics from the messages of a processed conversation
+func Calculate(result *interfaces.MessageResult, options Options) (*Result, error) {
+	if result == nil {
+		return nil, ErrInvalidInput
+	}
+
+	c := New(options)
+	c.AddMessages(result.Messages...)
+	return c.Result(), nil
+}
+
+// New creates an empty Calculator
+func New(options Options) *Calculator {
+	if options.SilenceThreshold <= 0 {
+		options.SilenceThreshold = DefaultSilenceThreshold
+	}
+	if options.InterruptionThreshold <= 0 {
+		options.InterruptionThreshold = DefaultInterruptionThreshold
+	}
+
+	return &Calculator{
+		options: options,
+		seen:    make(map[string]int),
+	}
+}
+
+// AddMessages adds messages from the async API
+func (c *Calculator) AddMessages(messages ...interfaces.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, message := range messages {
+		c.setBase(message.StartTime)
+	}
+	for _, message := range messages {
+		start, end := c.resolveTimes(message.StartTime, message.EndTime, message.TimeOffset, message.Duration)
+		c.add(Utterance{
+			ID:          message.ID,
+			SpeakerID:   message.From.ID,
+			SpeakerName: message.From.Name,
+			Text:        message.Text,
+			Start:       start,
+			End:         end,
+			Words:       countWords(message.Text, len(message.Words)),
+		})
+	}
+}
+
+// AddStreamingMessages adds messages received from the streaming API. Messages with an ID
+// that was already added replace the earlier version.
+func (c *Calculator) AddStreamingMessages(messages ...streaminginterfaces.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, message := range messages {
+		c.setBase(message.Duration.StartTime)
+	}
+	for _, message := range messages {
+		start, end := c.resolveTimes(message.Duration.StartTime, message.Duration.EndTime, message.Duration.TimeOffset, message.Duration.Duration)
+
+		speakerID := message.From.ID
+		if len(speakerID) == 0 {
+			speakerID = message.From.UserID
+		}
+
+		c.add(Utterance{
+			ID:          message.ID,
+			SpeakerID:   speakerID,
+			SpeakerName: message.From.Name,
+			Text:        message.Payload.Content,
+			Start:       start,
+			End:         end,
+			Words:       countWords(message.Payload.Content, 0),
+		})
+	}
+}
+
+// AddUtterance adds an utterance which has already been normalized
+func (c *Calculator) AddUtterance(utterance Utterance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.add(utterance)
+}
+
+// Result computes analytics over everything added so far
+func (c *Calculator) Result() *Result {
+	c.mu.Lock()
+	utterances := make([]Utterance, len(c.utterances))
+	copy(utterances, c.utterances)
+	c.mu.Unlock()
+
+	return calculate(utterances, c.options)
+}
+
+// add the caller must hold c.mu
+func (c *Calculator) add(utterance Utterance) {
+	if len(utterance.SpeakerID) == 0 {
+		utterance.SpeakerID = utterance.SpeakerName
+	}
+	if len(utterance.SpeakerID) == 0 {
+		utterance.SpeakerID = UnknownSpeakerID
+	}
+	if utterance.End < utterance.Start {
+		utterance.End = utterance.Start
+	}
+	if utterance.Words == 0 {
+		utterance.Words = countWords(utterance.Text, 0)
+	}
+
+	if len(utterance.ID) > 0 {
+		if i, ok := c.seen[utterance.ID]; ok {
+			c.utterances[i] = utterance
+			return
+		}
+		c.seen[utterance.ID] = len(c.utterances)
+	}
+	c.utterances = append(c.utterances, utterance)
+}
+
+// setBase tracks the earliest absolute time seen. The caller must hold c.mu.
+func (c *Calculator) setBase(t common.Timestamp) {
+	if t.IsZero() {
+		return
+	}
+	if c.base.IsZero() || t.Before(c.base) {
+		c.base = t.Time
+	}
+}
+
+// resolveTimes prefers the offset and duration and falls back to the absolute times. The
+// caller must hold c.mu.
+func (c *Calculator) resolveTimes(startTime, endTime common.Timestamp, offset, duration common.Seconds) (time.Duration, time.Duration) {
+	if offset > 0 || duration > 0 {
+		return offset.Duration(), offset.Duration() + duration.Duration()
+	}
+	if c.base.IsZero() || startTime.IsZero() {
+		return 0, 0
+	}
+
+	start := startTime.Sub(c.base)
+	end := start
+	if !endTime.IsZero() {
+		end = endTime.Sub(c.base)
+	}
+	return start, end
+}
+
+func countWords(text string, words int) int {
+	if words > 0 {
+		return words
+	}
+	return len(strings.Fields(text))
+}
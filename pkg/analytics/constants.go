@@ -0,0 +1,30 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package analytics
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// metric types matching the platform analytics
+	MetricTotalSilence  string = "total_silence"
+	MetricTotalTalkTime string = "total_talk_time"
+	MetricTotalOverlap  string = "total_overlap"
+
+	// DefaultSilenceThreshold is the shortest gap between speakers counted as silence
+	DefaultSilenceThreshold time.Duration = 2 * time.Second
+
+	// DefaultInterruptionThreshold is how far a speaker must talk over another to interrupt them
+	DefaultInterruptionThreshold time.Duration = 500 * time.Millisecond
+
+	// UnknownSpeakerID is used for messages without a speaker
+	UnknownSpeakerID string = "unknown"
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+)
@@ -0,0 +1,91 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package analytics
+
+import (
+	"sync"
+	"time"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// Options tunes the calculation
+type Options struct {
+	// SilenceThreshold is the shortest gap counted as silence, defaults to 2s
+	SilenceThreshold time.Duration
+	// InterruptionThreshold is the overlap required to count as an interruption, defaults to 500ms
+	InterruptionThreshold time.Duration
+}
+
+// Utterance is a single message normalized for analysis. Times are relative to the start of
+// the conversation.
+type Utterance struct {
+	ID          string
+	SpeakerID   string
+	SpeakerName string
+	Text        string
+	Start       time.Duration
+	End         time.Duration
+	Words       int
+}
+
+// Calculator accumulates utterances, for example from a live streaming transcript, and computes
+// analytics on demand
+type Calculator struct {
+	options Options
+
+	mu         sync.Mutex
+	utterances []Utterance
+	seen       map[string]int
+	base       time.Time
+}
+
+// Result is the AnalyticsResult shape returned by the platform plus the detail behind it
+type Result struct {
+	interfaces.AnalyticsResult
+
+	Duration         time.Duration
+	Speakers         []SpeakerStats
+	Overlaps         []OverlapEvent
+	Silences         []Gap
+	LongestMonologue *Monologue
+}
+
+// SpeakerStats are the per speaker results
+type SpeakerStats struct {
+	ID               string
+	Name             string
+	TalkTime         time.Duration
+	ListenTime       time.Duration
+	Words            int
+	WordsPerMinute   float64
+	Interruptions    int // times this speaker interrupted someone
+	Interrupted      int // times this speaker was interrupted
+	OverlapTime      time.Duration
+	LongestMonologue *Monologue
+}
+
+// OverlapEvent is a period where two speakers talk at the same time. Second started talking
+// while First was speaking.
+type OverlapEvent struct {
+	FirstSpeakerID  string
+	SecondSpeakerID string
+	Start           time.Duration
+	Duration        time.Duration
+	Interruption    bool
+}
+
+// Gap is a period where nobody is talking
+type Gap struct {
+	Start    time.Duration
+	Duration time.Duration
+}
+
+// Monologue is an uninterrupted run of utterances by one speaker
+type Monologue struct {
+	SpeakerID string
+	Start     time.Duration
+	Duration  time.Duration
+	Words     int
+}
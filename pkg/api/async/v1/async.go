@@ -5,14 +5,13 @@ package async
 
 import (
 	"context"
-	"net/http"
+	"io"
 	"time"
 
 	validator "gopkg.in/go-playground/validator.v9"
 	klog "k8s.io/klog/v2"
 
 	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
-	version "github.com/dvonthenen/symbl-go-sdk/pkg/api/version"
 	symbl "github.com/dvonthenen/symbl-go-sdk/pkg/client"
 )
 
@@ -29,6 +28,9 @@ func New(client *symbl.RestClient) *Client {
 	return &Client{client}
 }
 
+// PostText splits large transcripts into chunks using the default TextChunkOptions. A transcript
+// of more than one chunk blocks until the job of each chunk but the last completes, up to 10
+// minutes per job; see PostTextChunked.
 func (c *Client) PostText(ctx context.Context, messages []string) (*JobConversation, error) {
 	textRequest := interfaces.AsyncTextRequest{}
 
@@ -42,9 +44,12 @@ func (c *Client) PostText(ctx context.Context, messages []string) (*JobConversat
 		})
 	}
 
-	return c.PostTextWithOptions(ctx, textRequest)
+	return c.PostTextChunked(ctx, textRequest, TextChunkOptions{})
 }
 
+// PostAppendText splits large transcripts into chunks using the default TextChunkOptions. A
+// transcript of more than one chunk blocks until the job of each chunk but the last completes,
+// up to 10 minutes per job; see PostAppendTextChunked.
 func (c *Client) PostAppendText(ctx context.Context, conversationId string, messages []string) (*JobConversation, error) {
 	textRequest := interfaces.AsyncTextRequest{}
 
@@ -58,7 +63,7 @@ func (c *Client) PostAppendText(ctx context.Context, conversationId string, mess
 		})
 	}
 
-	return c.PostAppendTextWithOptions(ctx, conversationId, textRequest)
+	return c.PostAppendTextChunked(ctx, conversationId, textRequest, TextChunkOptions{})
 }
 
 func (c *Client) PostFile(ctx context.Context, filePath string) (*JobConversation, error) {
@@ -123,44 +128,64 @@ func (c *Client) PostFileWithOptions(ctx context.Context, filePath string, optio
 	return &jobConvo, nil
 }
 
-func (c *Client) WaitForJobCompleteOnce(ctx context.Context, jobId string) (bool, error) {
-	klog.V(6).Infof("async.WaitForJobCompleteOnce ENTER\n")
+// PostReader uploads media from an io.Reader such as a download stream from object storage
+func (c *Client) PostReader(ctx context.Context, r io.Reader, readerOptions interfaces.ReaderOptions) (*JobConversation, error) {
+	options := interfaces.AsyncOptions{
+		Name: readerOptions.Name,
+	}
+	return c.PostReaderWithOptions(ctx, r, readerOptions, options)
+}
+
+// PostReaderWithOptions same as PostReader but with processing options
+func (c *Client) PostReaderWithOptions(ctx context.Context, r io.Reader, readerOptions interfaces.ReaderOptions, options interfaces.AsyncOptions) (*JobConversation, error) {
+	klog.V(6).Infof("async.PostReaderWithOptions ENTER\n")
 
 	// checks
-	if jobId == "" {
-		return false, ErrInvalidInput
-	}
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	// request
-	URI := version.GetAsyncAPI(version.JobStatusURI, jobId)
-	klog.V(6).Infof("Calling %s\n", URI)
+	klog.V(3).Infof("name: %s\n", readerOptions.Name)
+	klog.V(3).Infof("contentType: %s\n", readerOptions.ContentType)
+
+	// send the stream!
+	var jobConvo JobConversation
 
-	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
+	err := c.DoReaderWithOptions(ctx, r, readerOptions, options, &jobConvo)
+	if e, ok := err.(*symbl.StatusError); ok {
+		klog.V(1).Infof("DoReader failed. HTTP Code: %v\n", e.Resp.StatusCode)
+		klog.V(6).Infof("async.PostReaderWithOptions LEAVE\n")
+		return nil, e
+	}
 	if err != nil {
-		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.WaitForJobCompleteOnce ENTER\n")
-		return false, err
+		klog.V(1).Infof("DoReader failed. Err: %v\n", err)
+		klog.V(6).Infof("async.PostReaderWithOptions LEAVE\n")
+		return nil, err
 	}
 
-	// check the status
-	var jobStatus JobStatus
+	klog.V(3).Infof("async.PostReaderWithOptions Succeeded\n")
+	klog.V(6).Infof("async.PostReaderWithOptions LEAVE\n")
+	return &jobConvo, nil
+}
 
-	err = c.Client.Do(ctx, req, &jobStatus)
+func (c *Client) WaitForJobCompleteOnce(ctx context.Context, jobId string) (bool, error) {
+	klog.V(6).Infof("async.WaitForJobCompleteOnce ENTER\n")
 
-	if e, ok := err.(*symbl.StatusError); ok {
-		if e.Resp.StatusCode != http.StatusOK {
-			klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-			klog.V(6).Infof("async.WaitForJobCompleteOnce LEAVE\n")
-			return false, err
-		}
+	jobStatus, err := c.GetJobStatus(ctx, jobId)
+	if err != nil {
+		klog.V(1).Infof("GetJobStatus failed. Err: %v\n", err)
+		klog.V(6).Infof("async.WaitForJobCompleteOnce LEAVE\n")
+		return false, err
+	}
+	if jobStatus.Status == JobStatusFailed {
+		klog.V(1).Infof("Job %s failed: %s\n", jobId, jobStatus.Message)
+		klog.V(6).Infof("async.WaitForJobCompleteOnce LEAVE\n")
+		return false, ErrJobFailed
 	}
 
 	complete := (jobStatus.Status == JobStatusComplete)
 
-	klog.V(3).Infof("%s: %t", URI, complete)
+	klog.V(3).Infof("%s: %t", jobId, complete)
 	klog.V(6).Infof("async.WaitForJobCompleteOnce LEAVE\n")
 	return complete, nil
 }
@@ -182,6 +207,11 @@ func (c *Client) PostTextWithOptions(ctx context.Context, textRequest interfaces
 		klog.V(6).Infof("async.PostTextWithOptions LEAVE\n")
 		return nil, e
 	}
+	if err != nil {
+		klog.V(1).Infof("DoTextWithOptions failed. Err: %v\n", err)
+		klog.V(6).Infof("async.PostTextWithOptions LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("async.PostTextWithOptions Succeeded\n")
 	klog.V(6).Infof("async.PostTextWithOptions LEAVE\n")
@@ -205,6 +235,11 @@ func (c *Client) PostAppendTextWithOptions(ctx context.Context, conversationId s
 		klog.V(6).Infof("async.PostAppendTextWithOptions LEAVE\n")
 		return nil, e
 	}
+	if err != nil {
+		klog.V(1).Infof("DoAppendTextWithOptions failed. Err: %v\n", err)
+		klog.V(6).Infof("async.PostAppendTextWithOptions LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("async.PostAppendTextWithOptions Succeeded\n")
 	klog.V(6).Infof("async.PostAppendTextWithOptions LEAVE\n")
@@ -236,36 +271,27 @@ func (c *Client) WaitForJobComplete(ctx context.Context, jobStatusOpts interface
 		ctx = context.Background()
 	}
 
-	numOfLoops := float64(defaultWaitForCompletion) / float64(defaultDelayBetweenCheck)
+	waitInSeconds := defaultWaitForCompletion
 	if jobStatusOpts.WaitInSeconds != interfaces.UseDefaultWaitForCompletion {
-		numOfLoops = float64(jobStatusOpts.WaitInSeconds) / float64(defaultDelayBetweenCheck)
+		waitInSeconds = jobStatusOpts.WaitInSeconds
 		klog.V(4).Infof("User provided jobStatusOpts.WaitInSeconds\n")
 	}
-	klog.V(5).Infof("numOfLoops: %f\n", numOfLoops)
-	klog.V(5).Infof("WaitInSeconds: %f\n", jobStatusOpts.WaitInSeconds)
-
-	for i := 1; i <= int(numOfLoops); i++ {
-		// delay on subsequent calls
-		if i > 1 {
-			klog.V(4).Info("Sleep for retry...\n")
-			time.Sleep(time.Second * time.Duration(defaultDelayBetweenCheck))
-		}
-
-		// check for completion
-		completed, err := c.WaitForJobCompleteOnce(ctx, jobStatusOpts.JobId)
-		if err != nil {
-			klog.V(1).Infof("WaitForJobCompleteOnce failed. Err: %v\n", err)
-			klog.V(6).Infof("async.WaitForJobComplete LEAVE\n")
-			return false, err
-		}
-		if completed {
-			klog.V(3).Info("WaitForJobCompleteOnce completed!\n")
-			klog.V(6).Infof("async.WaitForJobComplete LEAVE\n")
-			return true, nil
-		}
+	klog.V(5).Infof("WaitInSeconds: %d\n", waitInSeconds)
+
+	// fixed interval between checks
+	jobStatus, err := c.PollJobStatus(ctx, JobPollOptions{
+		JobId:        jobStatusOpts.JobId,
+		Timeout:      time.Second * time.Duration(waitInSeconds),
+		InitialDelay: time.Second * time.Duration(defaultDelayBetweenCheck),
+		Multiplier:   1,
+	})
+	if err != nil {
+		klog.V(1).Infof("PollJobStatus failed. Err: %v\n", err)
+		klog.V(6).Infof("async.WaitForJobComplete LEAVE\n")
+		return false, err
 	}
 
-	klog.V(1).Infof("job status timed out\n")
+	klog.V(3).Infof("WaitForJobComplete completed! Status: %s\n", jobStatus.Status)
 	klog.V(6).Infof("async.WaitForJobComplete LEAVE\n")
-	return false, ErrJobStatusTimeout
+	return true, nil
 }
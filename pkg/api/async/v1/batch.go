@@ -0,0 +1,392 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package async
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	atomicfile "github.com/dvonthenen/symbl-go-sdk/pkg/internal/atomicfile"
+)
+
+const (
+	defaultBatchWorkers    int           = 4
+	defaultBatchMaxRetries int           = 2
+	defaultBatchRetryDelay time.Duration = 5 * time.Second
+)
+
+// ProcessBatch submits many files and URLs using a pool of workers. Each item is retried on
+// failure and progress is saved to StatePath after every submission and every finished item
+// so the batch can be resumed.
+// The report is always returned; ErrBatchItemsFailed indicates at least one item failed.
+func (c *Client) ProcessBatch(ctx context.Context, items []BatchItem, options BatchOptions) (*BatchReport, error) {
+	klog.V(6).Infof("async.ProcessBatch ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if len(items) == 0 {
+		klog.V(1).Infof("items is empty\n")
+		klog.V(6).Infof("async.ProcessBatch LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	// defaults
+	if options.Workers <= 0 {
+		options.Workers = defaultBatchWorkers
+	}
+	if options.MaxRetries == 0 {
+		options.MaxRetries = defaultBatchMaxRetries
+	} else if options.MaxRetries < 0 {
+		options.MaxRetries = 0
+	}
+	if options.RetryDelay <= 0 {
+		options.RetryDelay = defaultBatchRetryDelay
+	}
+
+	// assign and validate IDs on a copy so the caller's items are left untouched
+	items = append([]BatchItem(nil), items...)
+	seen := make(map[string]bool)
+	for i := range items {
+		if len(items[i].ID) == 0 {
+			items[i].ID = items[i].FilePath
+			if len(items[i].ID) == 0 {
+				items[i].ID = items[i].Options.URL
+			}
+		}
+		if len(items[i].ID) == 0 || seen[items[i].ID] {
+			klog.V(1).Infof("Item %d has a missing or duplicate ID\n", i)
+			klog.V(6).Infof("async.ProcessBatch LEAVE\n")
+			return nil, ErrInvalidInput
+		}
+		seen[items[i].ID] = true
+	}
+
+	// resume
+	b := &batch{
+		client:  c,
+		options: options,
+		limiter: newRateLimiter(options.RequestsPerSecond),
+		report: &BatchReport{
+			Results: make(map[string]*BatchItemResult),
+		},
+	}
+	if len(options.StatePath) > 0 {
+		err := b.loadState()
+		if err != nil {
+			klog.V(1).Infof("loadState failed. Err: %v\n", err)
+			klog.V(6).Infof("async.ProcessBatch LEAVE\n")
+			return nil, err
+		}
+	}
+
+	// dispatch
+	work := make(chan BatchItem)
+	var wg sync.WaitGroup
+	for i := 0; i < options.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range work {
+				b.process(ctx, item)
+			}
+		}()
+	}
+
+dispatch:
+	for _, item := range items {
+		if b.isDone(item.ID) {
+			klog.V(4).Infof("Item %s already succeeded, skipping\n", item.ID)
+			b.mu.Lock()
+			b.report.Resumed++
+			b.mu.Unlock()
+			continue
+		}
+
+		select {
+		case work <- item:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	// summarize
+	b.mu.Lock()
+	b.report.Succeeded = 0
+	b.report.Failed = 0
+	for _, id := range itemIDs(items) {
+		result := b.report.Results[id]
+		if result == nil {
+			continue
+		}
+		if result.Status == BatchStatusSucceeded {
+			b.report.Succeeded++
+		} else {
+			b.report.Failed++
+		}
+	}
+	report := b.report
+	b.mu.Unlock()
+
+	if ctx.Err() != nil {
+		klog.V(1).Infof("Batch interrupted. Err: %v\n", ctx.Err())
+		klog.V(6).Infof("async.ProcessBatch LEAVE\n")
+		return report, ctx.Err()
+	}
+	if report.Failed > 0 {
+		klog.V(1).Infof("%d of %d items failed\n", report.Failed, len(items))
+		klog.V(6).Infof("async.ProcessBatch LEAVE\n")
+		return report, ErrBatchItemsFailed
+	}
+
+	klog.V(3).Infof("async.ProcessBatch Succeeded\n")
+	klog.V(6).Infof("async.ProcessBatch LEAVE\n")
+	return report, nil
+}
+
+type batch struct {
+	client  *Client
+	options BatchOptions
+	limiter *rateLimiter
+
+	mu     sync.Mutex
+	report *BatchReport
+}
+
+func (b *batch) isDone(id string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	result := b.report.Results[id]
+	return result != nil && result.Status == BatchStatusSucceeded
+}
+
+// previous returns the result recorded for id by an earlier run
+func (b *batch) previous(id string) *BatchItemResult {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.report.Results[id]
+}
+
+func (b *batch) process(ctx context.Context, item BatchItem) {
+	result := &BatchItemResult{
+		ID: item.ID,
+	}
+
+	// a job submitted by an earlier run is polled again rather than submitted twice
+	if previous := b.previous(item.ID); previous != nil && len(previous.JobID) > 0 {
+		klog.V(4).Infof("Resuming job %s for %s\n", previous.JobID, item.ID)
+		result.JobID = previous.JobID
+		result.ConversationID = previous.ConversationID
+		result.Attempts = previous.Attempts
+	}
+
+	delay := b.options.RetryDelay
+	for retry := 0; ; retry++ {
+		err := b.attempt(ctx, item, result)
+		if err == nil {
+			result.Status = BatchStatusSucceeded
+			result.Error = ""
+			break
+		}
+
+		klog.V(1).Infof("Item %s attempt %d failed. Err: %v\n", item.ID, retry+1, err)
+		result.Status = BatchStatusFailed
+		result.Error = err.Error()
+		if err == ErrInvalidInput || ctx.Err() != nil || retry >= b.options.MaxRetries {
+			break
+		}
+
+		// the platform failed the job so it has to be submitted again, any other polling
+		// failure keeps waiting on the same job
+		if err == ErrJobFailed {
+			result.JobID = ""
+			result.ConversationID = ""
+		}
+
+		klog.V(4).Infof("Retrying %s in %v\n", item.ID, delay)
+		if !sleepContext(ctx, delay) {
+			break
+		}
+		delay *= 2
+	}
+
+	// interrupted before the first submission, leave it for the next run
+	if result.Attempts == 0 {
+		return
+	}
+	result.FinishedAt = time.Now()
+	b.record(result)
+
+	if b.options.Callback != nil {
+		b.options.Callback(result)
+	}
+}
+
+// record stores a copy of result in the report and saves the progress state
+func (b *batch) record(result *BatchItemResult) {
+	saved := *result
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.report.Results[result.ID] = &saved
+	if len(b.options.StatePath) > 0 {
+		err := b.saveState()
+		if err != nil {
+			klog.V(1).Infof("saveState failed. Err: %v\n", err)
+		}
+	}
+}
+
+// attempt submits the item unless result already holds a job, then waits for the job when
+// requested. The job is saved to the progress state as soon as it is submitted so a later
+// run polls it instead of submitting the item again.
+func (b *batch) attempt(ctx context.Context, item BatchItem, result *BatchItemResult) error {
+	if len(result.JobID) == 0 {
+		err := b.limiter.Wait(ctx)
+		if err != nil {
+			return err
+		}
+
+		result.Attempts++
+		jobConvo, err := b.submit(ctx, item)
+		if err != nil {
+			return err
+		}
+		result.JobID = jobConvo.JobID
+		result.ConversationID = jobConvo.ConversationID
+		result.Status = BatchStatusSubmitted
+		b.record(result)
+	}
+
+	if b.options.WaitForCompletion {
+		pollOpts := b.options.Poll
+		pollOpts.JobId = result.JobID
+
+		_, err := b.client.PollJobStatus(ctx, pollOpts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *batch) submit(ctx context.Context, item BatchItem) (*JobConversation, error) {
+	var jobConvo *JobConversation
+	var err error
+	if len(item.FilePath) > 0 {
+		jobConvo, err = b.client.PostFileWithOptions(ctx, item.FilePath, item.Options)
+	} else {
+		jobConvo, err = b.client.PostURLWithOptions(ctx, item.Options)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if jobConvo == nil || len(jobConvo.JobID) == 0 {
+		return nil, errors.New("the platform did not return a job")
+	}
+
+	return jobConvo, nil
+}
+
+func (b *batch) loadState() error {
+	data, err := os.ReadFile(b.options.StatePath)
+	if errors.Is(err, os.ErrNotExist) {
+		klog.V(4).Infof("No batch state found at %s\n", b.options.StatePath)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var report BatchReport
+	err = json.Unmarshal(data, &report)
+	if err != nil {
+		return err
+	}
+	if report.Results != nil {
+		b.report.Results = report.Results
+	}
+
+	klog.V(3).Infof("Resuming batch with %d previous results\n", len(b.report.Results))
+	return nil
+}
+
+// saveState writes the report atomically. The caller must hold b.mu.
+func (b *batch) saveState() error {
+	data, err := json.MarshalIndent(b.report, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.WriteFile(b.options.StatePath, data)
+}
+
+func itemIDs(items []BatchItem) []string {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
+
+func sleepContext(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// rateLimiter spaces out calls to Wait so no more than perSecond proceed each second
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+func newRateLimiter(perSecond float64) *rateLimiter {
+	r := &rateLimiter{}
+	if perSecond > 0 {
+		r.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+	return r
+}
+
+func (r *rateLimiter) Wait(ctx context.Context) error {
+	if r.interval == 0 {
+		return ctx.Err()
+	}
+
+	r.mu.Lock()
+	now := time.Now()
+	if r.next.Before(now) {
+		r.next = now
+	}
+	wait := r.next.Sub(now)
+	r.next = r.next.Add(r.interval)
+	r.mu.Unlock()
+
+	if wait > 0 && !sleepContext(ctx, wait) {
+		return ctx.Err()
+	}
+	return ctx.Err()
+}
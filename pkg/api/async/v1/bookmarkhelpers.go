@@ -0,0 +1,348 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package async
+
+import (
+	"context"
+	"time"
+
+	validator "gopkg.in/go-playground/validator.v9"
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
+// CreateBookmarkForTimeRange bookmarks every message overlapping [begin, end) of the
+// conversation. Label, Description and User are taken from request.
+func (c *Client) CreateBookmarkForTimeRange(ctx context.Context, conversationId string, request interfaces.BookmarkRequest, begin, end time.Duration) (*interfaces.Bookmark, error) {
+	klog.V(6).Infof("async.CreateBookmarkForTimeRange ENTER\n")
+
+	// checks
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.CreateBookmarkForTimeRange LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	messages, err := c.GetMessages(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetMessages failed. Err: %v\n", err)
+		klog.V(6).Infof("async.CreateBookmarkForTimeRange LEAVE\n")
+		return nil, err
+	}
+
+	request, err = NewBookmarkRequestForTimeRange(messages, request, begin, end)
+	if err != nil {
+		klog.V(1).Infof("NewBookmarkRequestForTimeRange failed. Err: %v\n", err)
+		klog.V(6).Infof("async.CreateBookmarkForTimeRange LEAVE\n")
+		return nil, err
+	}
+
+	bookmark, err := c.CreateBookmark(ctx, conversationId, request)
+	if err != nil {
+		klog.V(1).Infof("CreateBookmark failed. Err: %v\n", err)
+		klog.V(6).Infof("async.CreateBookmarkForTimeRange LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("async.CreateBookmarkForTimeRange Succeeded\n")
+	klog.V(6).Infof("async.CreateBookmarkForTimeRange LEAVE\n")
+	return bookmark, nil
+}
+
+// CreateBookmarkForMessages bookmarks the given messages. The time range spans from the start
+// of the earliest message to the end of the latest.
+func (c *Client) CreateBookmarkForMessages(ctx context.Context, conversationId string, request interfaces.BookmarkRequest, messageIds []string) (*interfaces.Bookmark, error) {
+	klog.V(6).Infof("async.CreateBookmarkForMessages ENTER\n")
+
+	// checks
+	if len(conversationId) == 0 || len(messageIds) == 0 {
+		klog.V(1).Infof("conversationId or messageIds is empty\n")
+		klog.V(6).Infof("async.CreateBookmarkForMessages LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	messages, err := c.GetMessages(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetMessages failed. Err: %v\n", err)
+		klog.V(6).Infof("async.CreateBookmarkForMessages LEAVE\n")
+		return nil, err
+	}
+
+	request, err = NewBookmarkRequestForMessages(messages, request, messageIds)
+	if err != nil {
+		klog.V(1).Infof("NewBookmarkRequestForMessages failed. Err: %v\n", err)
+		klog.V(6).Infof("async.CreateBookmarkForMessages LEAVE\n")
+		return nil, err
+	}
+
+	bookmark, err := c.CreateBookmark(ctx, conversationId, request)
+	if err != nil {
+		klog.V(1).Infof("CreateBookmark failed. Err: %v\n", err)
+		klog.V(6).Infof("async.CreateBookmarkForMessages LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("async.CreateBookmarkForMessages Succeeded\n")
+	klog.V(6).Infof("async.CreateBookmarkForMessages LEAVE\n")
+	return bookmark, nil
+}
+
+// NewBookmarkRequestForTimeRange fills in the time range and message references of request
+// from messages already retrieved with GetMessages
+func NewBookmarkRequestForTimeRange(messages *interfaces.MessageResult, request interfaces.BookmarkRequest, begin, end time.Duration) (interfaces.BookmarkRequest, error) {
+	if messages == nil {
+		return request, ErrInvalidInput
+	}
+	if begin < 0 || end <= begin {
+		klog.V(1).Infof("Invalid range %v - %v\n", begin, end)
+		return request, ErrInvalidBookmarkRange
+	}
+
+	base := conversationBase(messages)
+
+	request.MessageRefs = nil
+	for _, message := range messages.Messages {
+		start, stop := messageRange(message, base)
+		overlaps := start < end && stop > begin
+		if stop == start {
+			overlaps = start >= begin && start < end
+		}
+		if overlaps {
+			request.MessageRefs = append(request.MessageRefs, interfaces.MessageRefRequest{ID: message.ID})
+		}
+	}
+	if len(request.MessageRefs) == 0 {
+		klog.V(1).Infof("No messages in range %v - %v\n", begin, end)
+		return request, ErrNoMessagesInRange
+	}
+
+	setBookmarkRange(&request, begin, end)
+	return request, nil
+}
+
+// NewBookmarkRequestForMessages fills in the time range and message references of request
+// from messages already retrieved with GetMessages. Every ID must be found.
+func NewBookmarkRequestForMessages(messages *interfaces.MessageResult, request interfaces.BookmarkRequest, messageIds []string) (interfaces.BookmarkRequest, error) {
+	if messages == nil || len(messageIds) == 0 {
+		return request, ErrInvalidInput
+	}
+
+	wanted := make(map[string]bool)
+	for _, id := range messageIds {
+		wanted[id] = true
+	}
+
+	base := conversationBase(messages)
+
+	// references follow the order of the conversation
+	var begin, end time.Duration
+	request.MessageRefs = nil
+	for _, message := range messages.Messages {
+		if !wanted[message.ID] {
+			continue
+		}
+		delete(wanted, message.ID)
+
+		start, stop := messageRange(message, base)
+		if len(request.MessageRefs) == 0 || start < begin {
+			begin = start
+		}
+		if stop > end {
+			end = stop
+		}
+		request.MessageRefs = append(request.MessageRefs, interfaces.MessageRefRequest{ID: message.ID})
+	}
+	for id := range wanted {
+		klog.V(1).Infof("Message %s not found\n", id)
+		return request, ErrMessageNotFound
+	}
+
+	setBookmarkRange(&request, begin, end)
+	return request, nil
+}
+
+// CreateBookmarks validates every request before creating any bookmark. When a request fails
+// the bookmarks already created are returned with the error.
+func (c *Client) CreateBookmarks(ctx context.Context, conversationId string, requests []interfaces.BookmarkRequest) ([]*interfaces.Bookmark, error) {
+	klog.V(6).Infof("async.CreateBookmarks ENTER\n")
+
+	// checks
+	if len(conversationId) == 0 || len(requests) == 0 {
+		klog.V(1).Infof("conversationId or requests is empty\n")
+		klog.V(6).Infof("async.CreateBookmarks LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	for i, request := range requests {
+		err := validateBookmarkRequest(request)
+		if err != nil {
+			klog.V(1).Infof("Request %d is invalid. Err: %v\n", i, err)
+			klog.V(6).Infof("async.CreateBookmarks LEAVE\n")
+			return nil, err
+		}
+	}
+
+	bookmarks := make([]*interfaces.Bookmark, 0, len(requests))
+	for i, request := range requests {
+		bookmark, err := c.CreateBookmark(ctx, conversationId, request)
+		if err != nil {
+			klog.V(1).Infof("CreateBookmark %d failed. Err: %v\n", i, err)
+			klog.V(6).Infof("async.CreateBookmarks LEAVE\n")
+			return bookmarks, err
+		}
+		bookmarks = append(bookmarks, bookmark)
+	}
+
+	klog.V(3).Infof("async.CreateBookmarks Succeeded\n")
+	klog.V(6).Infof("async.CreateBookmarks LEAVE\n")
+	return bookmarks, nil
+}
+
+// UpdateBookmarks validates every update before applying any. When an update fails the
+// bookmarks already updated are returned with the error.
+func (c *Client) UpdateBookmarks(ctx context.Context, conversationId string, updates []BookmarkUpdate) ([]*interfaces.Bookmark, error) {
+	klog.V(6).Infof("async.UpdateBookmarks ENTER\n")
+
+	// checks
+	if len(conversationId) == 0 || len(updates) == 0 {
+		klog.V(1).Infof("conversationId or updates is empty\n")
+		klog.V(6).Infof("async.UpdateBookmarks LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	v := validator.New()
+	seen := make(map[string]bool)
+	for i, update := range updates {
+		err := v.Struct(update)
+		if err == nil {
+			err = validateBookmarkRequest(update.Request)
+		}
+		if err != nil {
+			klog.V(1).Infof("Update %d is invalid. Err: %v\n", i, err)
+			klog.V(6).Infof("async.UpdateBookmarks LEAVE\n")
+			return nil, err
+		}
+		if seen[update.BookmarkId] {
+			klog.V(1).Infof("Bookmark %s is updated more than once\n", update.BookmarkId)
+			klog.V(6).Infof("async.UpdateBookmarks LEAVE\n")
+			return nil, ErrInvalidInput
+		}
+		seen[update.BookmarkId] = true
+	}
+
+	bookmarks := make([]*interfaces.Bookmark, 0, len(updates))
+	for _, update := range updates {
+		bookmark, err := c.UpdateBookmark(ctx, conversationId, update.BookmarkId, update.Request)
+		if err != nil {
+			klog.V(1).Infof("UpdateBookmark %s failed. Err: %v\n", update.BookmarkId, err)
+			klog.V(6).Infof("async.UpdateBookmarks LEAVE\n")
+			return bookmarks, err
+		}
+		bookmarks = append(bookmarks, bookmark)
+	}
+
+	klog.V(3).Infof("async.UpdateBookmarks Succeeded\n")
+	klog.V(6).Infof("async.UpdateBookmarks LEAVE\n")
+	return bookmarks, nil
+}
+
+// DeleteBookmarks checks every ID before deleting any. When a delete fails the remaining
+// bookmarks are left in place.
+func (c *Client) DeleteBookmarks(ctx context.Context, conversationId string, bookmarkIds []string) error {
+	klog.V(6).Infof("async.DeleteBookmarks ENTER\n")
+
+	// checks
+	if len(conversationId) == 0 || len(bookmarkIds) == 0 {
+		klog.V(1).Infof("conversationId or bookmarkIds is empty\n")
+		klog.V(6).Infof("async.DeleteBookmarks LEAVE\n")
+		return ErrInvalidInput
+	}
+	seen := make(map[string]bool)
+	for _, bookmarkId := range bookmarkIds {
+		if len(bookmarkId) == 0 || seen[bookmarkId] {
+			klog.V(1).Infof("bookmarkIds contains an empty or duplicate ID\n")
+			klog.V(6).Infof("async.DeleteBookmarks LEAVE\n")
+			return ErrInvalidInput
+		}
+		seen[bookmarkId] = true
+	}
+
+	for _, bookmarkId := range bookmarkIds {
+		err := c.DeleteBookmark(ctx, conversationId, bookmarkId)
+		if err != nil {
+			klog.V(1).Infof("DeleteBookmark %s failed. Err: %v\n", bookmarkId, err)
+			klog.V(6).Infof("async.DeleteBookmarks LEAVE\n")
+			return err
+		}
+	}
+
+	klog.V(3).Infof("async.DeleteBookmarks Succeeded\n")
+	klog.V(6).Infof("async.DeleteBookmarks LEAVE\n")
+	return nil
+}
+
+// validateBookmarkRequest applies the struct validation and requires either message references
+// or a positive duration
+func validateBookmarkRequest(request interfaces.BookmarkRequest) error {
+	v := validator.New()
+	err := v.Struct(request)
+	if err != nil {
+		return err
+	}
+	if request.BeginTimeOffset < 0 || request.Duration < 0 {
+		return ErrInvalidBookmarkRange
+	}
+	if len(request.MessageRefs) == 0 && request.Duration == 0 {
+		return ErrInvalidBookmarkRange
+	}
+	for _, ref := range request.MessageRefs {
+		if len(ref.ID) == 0 {
+			return ErrInvalidInput
+		}
+	}
+	return nil
+}
+
+// setBookmarkRange widens [begin, end) to whole seconds, which is what the platform accepts,
+// so the bookmark still covers the selected messages
+func setBookmarkRange(request *interfaces.BookmarkRequest, begin, end time.Duration) {
+	begin = begin.Truncate(time.Second)
+	if end%time.Second != 0 {
+		end = end.Truncate(time.Second) + time.Second
+	}
+
+	request.BeginTimeOffset = common.NewWholeSeconds(begin)
+	request.Duration = common.NewWholeSeconds(end - begin)
+}
+
+// conversationBase is the earliest message start time, used when offsets are missing
+func conversationBase(messages *interfaces.MessageResult) time.Time {
+	var base time.Time
+	for _, message := range messages.Messages {
+		if !message.StartTime.IsZero() && (base.IsZero() || message.StartTime.Before(base)) {
+			base = message.StartTime.Time
+		}
+	}
+	return base
+}
+
+// messageRange is the offset of a message from the start of the conversation. The platform
+// offsets are used when present, otherwise the message times relative to base.
+func messageRange(message interfaces.Message, base time.Time) (time.Duration, time.Duration) {
+	if message.TimeOffset > 0 || message.Duration > 0 {
+		start := message.TimeOffset.Duration()
+		return start, start + message.Duration.Duration()
+	}
+	if base.IsZero() || message.StartTime.IsZero() {
+		return 0, 0
+	}
+
+	start := message.StartTime.Sub(base)
+	end := start
+	if message.EndTime.After(message.StartTime.Time) {
+		end = message.EndTime.Sub(base)
+	}
+	return start, end
+}
@@ -0,0 +1,198 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package async
+
+import (
+	"context"
+	"encoding/json"
+
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// PostTextChunked posts the first chunk of messages with PostTextWithOptions and appends the
+// remaining chunks, in order, to the same conversation with PostAppendTextWithOptions. The job
+// returned is that of the final chunk and carries the conversation ID. WebhookURL is only sent
+// with the final chunk.
+//
+// Each chunk's job must complete before the next chunk is appended, so a request with more
+// than one chunk blocks until then. Poll.Timeout bounds the wait for each job and defaults
+// to 10 minutes.
+//
+// When a later chunk fails, the conversation already holds the earlier chunks. The job of the
+// last chunk posted is returned along with the error so the conversation can be resumed with
+// PostAppendTextChunked or deleted.
+func (c *Client) PostTextChunked(ctx context.Context, textRequest interfaces.AsyncTextRequest, options TextChunkOptions) (*JobConversation, error) {
+	klog.V(6).Infof("async.PostTextChunked ENTER\n")
+
+	jobConvo, err := c.postTextChunked(ctx, "", textRequest, options)
+	if err != nil {
+		klog.V(1).Infof("postTextChunked failed. Err: %v\n", err)
+		klog.V(6).Infof("async.PostTextChunked LEAVE\n")
+		return jobConvo, err
+	}
+
+	klog.V(3).Infof("async.PostTextChunked Succeeded\n")
+	klog.V(6).Infof("async.PostTextChunked LEAVE\n")
+	return jobConvo, nil
+}
+
+// PostAppendTextChunked appends the messages to an existing conversation, one chunk at a time.
+// When a chunk fails, the job of the last chunk posted, if any, is returned along with the error.
+func (c *Client) PostAppendTextChunked(ctx context.Context, conversationId string, textRequest interfaces.AsyncTextRequest, options TextChunkOptions) (*JobConversation, error) {
+	klog.V(6).Infof("async.PostAppendTextChunked ENTER\n")
+
+	// checks
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.PostAppendTextChunked LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	jobConvo, err := c.postTextChunked(ctx, conversationId, textRequest, options)
+	if err != nil {
+		klog.V(1).Infof("postTextChunked failed. Err: %v\n", err)
+		klog.V(6).Infof("async.PostAppendTextChunked LEAVE\n")
+		return jobConvo, err
+	}
+
+	klog.V(3).Infof("async.PostAppendTextChunked Succeeded\n")
+	klog.V(6).Infof("async.PostAppendTextChunked LEAVE\n")
+	return jobConvo, nil
+}
+
+// postTextChunked creates a new conversation from the first chunk when conversationId is empty.
+// On failure the job of the last chunk successfully posted is returned with the error.
+func (c *Client) postTextChunked(ctx context.Context, conversationId string, textRequest interfaces.AsyncTextRequest, options TextChunkOptions) (*JobConversation, error) {
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if len(textRequest.Messages) == 0 {
+		klog.V(1).Infof("Messages is empty\n")
+		return nil, ErrInvalidInput
+	}
+
+	// defaults
+	if options.MaxMessages <= 0 {
+		options.MaxMessages = defaultChunkMaxMessages
+	}
+	if options.MaxBytes <= 0 {
+		options.MaxBytes = defaultChunkMaxBytes
+	}
+	if options.Poll.Timeout == 0 {
+		options.Poll.Timeout = defaultChunkPollTimeout
+	}
+
+	// the request options are sent with every chunk and count towards MaxBytes
+	envelope := textRequest
+	envelope.Messages = nil
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		klog.V(1).Infof("json.Marshal failed. Err: %v\n", err)
+		return nil, err
+	}
+	maxBytes := options.MaxBytes - len(data) - len(`"messages":[],`)
+	if maxBytes <= 0 {
+		klog.V(1).Infof("Request options of %d bytes exceed MaxBytes %d\n", len(data), options.MaxBytes)
+		return nil, ErrInvalidInput
+	}
+
+	// split everything up front so an oversized message fails before anything is posted
+	chunks, err := splitTextMessages(textRequest.Messages, options.MaxMessages, maxBytes)
+	if err != nil {
+		klog.V(1).Infof("splitTextMessages failed. Err: %v\n", err)
+		return nil, err
+	}
+	klog.V(4).Infof("Posting %d messages in %d chunks\n", len(textRequest.Messages), len(chunks))
+
+	var jobConvo *JobConversation
+	for i, chunk := range chunks {
+		// the previous job must finish before more messages are appended
+		if jobConvo != nil {
+			err = c.waitForChunk(ctx, jobConvo.JobID, options.Poll)
+			if err != nil {
+				klog.V(1).Infof("Chunk %d did not complete. Err: %v\n", i, err)
+				return jobConvo, err
+			}
+		}
+
+		request := textRequest
+		request.Messages = chunk
+		if i < len(chunks)-1 {
+			// only notify once the final chunk is processed
+			request.WebhookURL = ""
+		}
+
+		var posted *JobConversation
+		if len(conversationId) == 0 {
+			posted, err = c.PostTextWithOptions(ctx, request)
+		} else {
+			posted, err = c.PostAppendTextWithOptions(ctx, conversationId, request)
+		}
+		if err == nil && (posted == nil || len(posted.JobID) == 0) {
+			err = ErrJobNotReturned
+		}
+		if err != nil {
+			klog.V(1).Infof("Chunk %d failed. Err: %v\n", i+1, err)
+			return jobConvo, err
+		}
+
+		jobConvo = posted
+		if len(jobConvo.ConversationID) > 0 {
+			conversationId = jobConvo.ConversationID
+		}
+		klog.V(4).Infof("Chunk %d of %d posted. JobID: %s\n", i+1, len(chunks), jobConvo.JobID)
+	}
+
+	if options.WaitForCompletion {
+		err = c.waitForChunk(ctx, jobConvo.JobID, options.Poll)
+		if err != nil {
+			klog.V(1).Infof("Final chunk did not complete. Err: %v\n", err)
+			return jobConvo, err
+		}
+	}
+
+	return jobConvo, nil
+}
+
+func (c *Client) waitForChunk(ctx context.Context, jobId string, poll JobPollOptions) error {
+	poll.JobId = jobId
+	_, err := c.PollJobStatus(ctx, poll)
+	return err
+}
+
+// splitTextMessages groups messages, in order, into chunks within both limits. The size of a
+// chunk is estimated from the JSON encoding of its messages.
+func splitTextMessages(messages []interfaces.TextMessage, maxMessages, maxBytes int) ([][]interfaces.TextMessage, error) {
+	var chunks [][]interfaces.TextMessage
+	var current []interfaces.TextMessage
+	currentBytes := 0
+
+	for _, message := range messages {
+		data, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		size := len(data) + 1 // separating comma
+		if size > maxBytes {
+			klog.V(1).Infof("Message of %d bytes exceeds MaxBytes %d\n", size, maxBytes)
+			return nil, ErrTextMessageTooLarge
+		}
+
+		if len(current) == maxMessages || currentBytes+size > maxBytes {
+			chunks = append(chunks, current)
+			current = nil
+			currentBytes = 0
+		}
+		current = append(current, message)
+		currentBytes += size
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+
+	return chunks, nil
+}
@@ -5,11 +5,42 @@ package async
 
 import (
 	"errors"
+	"time"
 )
 
 const (
+	defaultChunkMaxMessages int           = 500
+	defaultChunkMaxBytes    int           = 512 * 1024
+	defaultChunkPollTimeout time.Duration = 10 * time.Minute
+
+	JobStatusScheduled  string = "scheduled"
 	JobStatusInProgress string = "in_progress"
 	JobStatusComplete   string = "completed"
+	JobStatusFailed     string = "failed"
+
+	// insights fetched by FetchInsights and ProcessConversation
+	InsightMessages    string = "messages"
+	InsightTopics      string = "topics"
+	InsightQuestions   string = "questions"
+	InsightActionItems string = "action_items"
+	InsightFollowUps   string = "follow_ups"
+	InsightEntities    string = "entities"
+	InsightSummary     string = "summary"
+	InsightAnalytics   string = "analytics"
+	InsightTrackers    string = "trackers"
+	InsightMembers     string = "members"
+
+	// conversation list order
+	OrderAscending  string = "asc"
+	OrderDescending string = "desc"
+
+	// batch item status
+	BatchStatusSubmitted string = "submitted"
+	BatchStatusSucceeded string = "succeeded"
+	BatchStatusFailed    string = "failed"
+
+	// WebhookTokenParam is the query parameter checked by WebhookHandler when a Token is configured
+	WebhookTokenParam string = "token"
 )
 
 var (
@@ -19,9 +50,71 @@ var (
 	// ErrJobStatusTimeout the job status check timed out
 	ErrJobStatusTimeout = errors.New("the job status check timed out")
 
+	// ErrJobFailed the platform reported the job as failed
+	ErrJobFailed = errors.New("the platform reported the job as failed")
+
 	// ErrInvalidWaitTime the time to wait agurment is invalid
 	ErrInvalidWaitTime = errors.New("the time to wait agurment is invalid")
 
+	// ErrPartialInsights one or more insights could not be fetched
+	ErrPartialInsights = errors.New("one or more insights could not be fetched")
+
+	// ErrInvalidInsightType the insight type is not supported
+	ErrInvalidInsightType = errors.New("the insight type is not supported")
+
+	// ErrPaginationNotSupported the platform returned the same page again instead of advancing
+	ErrPaginationNotSupported = errors.New("the platform returned the same page again instead of advancing")
+
+	// ErrBatchItemsFailed one or more batch items failed
+	ErrBatchItemsFailed = errors.New("one or more batch items failed")
+
+	// ErrWebhookUnauthorized the webhook token did not match
+	ErrWebhookUnauthorized = errors.New("the webhook token did not match")
+
+	// ErrWebhookUnknownJob the webhook references a job that was not registered
+	ErrWebhookUnknownJob = errors.New("the webhook references a job that was not registered")
+
+	// ErrJobNotReturned the platform accepted the request without returning a job
+	ErrJobNotReturned = errors.New("the platform accepted the request without returning a job")
+
+	// ErrTextMessageTooLarge a single text message exceeds the request size limit
+	ErrTextMessageTooLarge = errors.New("a single text message exceeds the request size limit")
+
+	// ErrInvalidBookmarkRange the bookmark must cover a positive time range or reference messages
+	ErrInvalidBookmarkRange = errors.New("the bookmark must cover a positive time range or reference messages")
+
+	// ErrMessageNotFound the message was not found in the conversation
+	ErrMessageNotFound = errors.New("the message was not found in the conversation")
+
+	// ErrNoMessagesInRange no messages fall within the bookmark time range
+	ErrNoMessagesInRange = errors.New("no messages fall within the bookmark time range")
+
 	// ErrInvalidURIExtension couldn't find a period to indicate a file extension
 	ErrInvalidURIExtension = errors.New("couldn't find a period to indicate a file extension")
 )
+
+var (
+	// DefaultInsights are fetched when no insights are requested
+	DefaultInsights = []string{
+		InsightMessages,
+		InsightTopics,
+		InsightQuestions,
+		InsightActionItems,
+		InsightFollowUps,
+		InsightSummary,
+	}
+
+	// AllInsights is every insight supported by FetchInsights
+	AllInsights = []string{
+		InsightMessages,
+		InsightTopics,
+		InsightQuestions,
+		InsightActionItems,
+		InsightFollowUps,
+		InsightEntities,
+		InsightSummary,
+		InsightAnalytics,
+		InsightTrackers,
+		InsightMembers,
+	}
+)
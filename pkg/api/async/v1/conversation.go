@@ -4,8 +4,14 @@
 package async
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	klog "k8s.io/klog/v2"
 
@@ -16,21 +22,47 @@ import (
 )
 
 func (c *Client) GetConversations(ctx context.Context) (*interfaces.ConversationsResult, error) {
-	klog.V(6).Infof("async.GetConversations ENTER\n")
+	return c.GetConversationsWithOptions(ctx, ConversationListOptions{})
+}
+
+// GetConversationsWithOptions returns a single page of conversations matching options. Use
+// ListConversations to walk every page.
+func (c *Client) GetConversationsWithOptions(ctx context.Context, options ConversationListOptions) (*interfaces.ConversationsResult, error) {
+	klog.V(6).Infof("async.GetConversationsWithOptions ENTER\n")
 
 	// checks
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	if options.Limit < 0 || options.Offset < 0 {
+		klog.V(1).Infof("Limit and Offset must not be negative\n")
+		klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if !options.StartTime.IsZero() && !options.EndTime.IsZero() && options.EndTime.Before(options.StartTime) {
+		klog.V(1).Infof("EndTime is before StartTime\n")
+		klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	switch options.Order {
+	case "", OrderAscending, OrderDescending:
+	default:
+		klog.V(1).Infof("Invalid order: %s\n", options.Order)
+		klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
+		return nil, ErrInvalidInput
+	}
 
 	// request
 	URI := version.GetAsyncAPI(version.ConversationsURI)
+	if params := encodeConversationListOptions(options); len(params) > 0 {
+		URI = fmt.Sprintf("%s?%s", URI, params.Encode())
+	}
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.GetConversations LEAVE\n")
+		klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
 		return nil, err
 	}
 
@@ -42,16 +74,148 @@ func (c *Client) GetConversations(ctx context.Context) (*interfaces.Conversation
 	if e, ok := err.(*symbl.StatusError); ok {
 		if e.Resp.StatusCode != http.StatusOK {
 			klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-			klog.V(6).Infof("async.GetConversations LEAVE\n")
+			klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Conversations succeeded\n")
-	klog.V(6).Infof("async.GetConversations LEAVE\n")
+	klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
 	return &result, nil
 }
 
+// ListConversations returns an iterator over every conversation matching options, fetching
+// additional pages as needed
+//
+//	it := asyncClient.ListConversations(ctx, async.ConversationListOptions{Limit: 50})
+//	for it.Next() {
+//		conversation := it.Conversation()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+func (c *Client) ListConversations(ctx context.Context, options ConversationListOptions) *ConversationIterator {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &ConversationIterator{
+		client:  c,
+		ctx:     ctx,
+		options: options,
+	}
+}
+
+// Next advances to the next conversation and returns false when there are no more or an error occurred
+func (it *ConversationIterator) Next() bool {
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			it.current = nil
+			return false
+		}
+		it.fetch()
+	}
+
+	it.current = &it.page[it.index]
+	it.index++
+	return true
+}
+
+// Conversation returns the current conversation
+func (it *ConversationIterator) Conversation() *interfaces.Conversation {
+	return it.current
+}
+
+// Err returns the error that stopped the iteration, if any. ErrPaginationNotSupported means
+// the platform stopped advancing so the listing is incomplete.
+func (it *ConversationIterator) Err() error {
+	return it.err
+}
+
+// fetch loads the next page and works out where the following page starts
+func (it *ConversationIterator) fetch() {
+	result, err := it.client.GetConversationsWithOptions(it.ctx, it.options)
+	if err != nil {
+		klog.V(1).Infof("GetConversationsWithOptions failed. Err: %v\n", err)
+		it.err = err
+		return
+	}
+
+	// a platform which ignores offset keeps returning the same page
+	if len(result.Conversations) > 0 && len(it.firstID) > 0 && result.Conversations[0].ID == it.firstID {
+		klog.V(1).Infof("Page repeats the previous page\n")
+		it.page = nil
+		it.index = 0
+		it.err = ErrPaginationNotSupported
+		return
+	}
+
+	it.page = result.Conversations
+	it.index = 0
+	klog.V(4).Infof("Fetched page of %d conversations\n", len(it.page))
+
+	if len(it.page) > 0 {
+		it.firstID = it.page[0].ID
+	}
+
+	switch {
+	case len(it.page) == 0:
+		it.done = true
+	case len(result.Next) > 0:
+		if result.Next == it.options.Cursor {
+			// the rest of the listing can't be reached
+			klog.V(1).Infof("Cursor repeats the previous cursor\n")
+			it.err = ErrPaginationNotSupported
+		}
+		it.options.Cursor = result.Next
+	case len(it.options.Cursor) > 0:
+		// the platform stopped returning a cursor
+		it.done = true
+	case it.options.Limit > 0 && len(it.page) < it.options.Limit:
+		it.done = true
+	case it.options.Limit == 0:
+		// without a page size there's no way to tell if this page is partial
+		it.options.Limit = len(it.page)
+		it.options.Offset += len(it.page)
+	default:
+		it.options.Offset += len(it.page)
+	}
+}
+
+func encodeConversationListOptions(options ConversationListOptions) url.Values {
+	params := url.Values{}
+	if options.Limit > 0 {
+		params.Set("limit", strconv.Itoa(options.Limit))
+	}
+	if len(options.Cursor) > 0 {
+		params.Set("cursor", options.Cursor)
+	} else if options.Offset > 0 {
+		params.Set("offset", strconv.Itoa(options.Offset))
+	}
+	if len(options.Order) > 0 {
+		params.Set("order", options.Order)
+	}
+	if len(options.Sort) > 0 {
+		params.Set("sort", options.Sort)
+	}
+	if !options.StartTime.IsZero() {
+		params.Set("startTime", options.StartTime.UTC().Format(time.RFC3339))
+	}
+	if !options.EndTime.IsZero() {
+		params.Set("endTime", options.EndTime.UTC().Format(time.RFC3339))
+	}
+	if len(options.GroupID) > 0 {
+		params.Set("groupId", options.GroupID)
+	}
+	if len(options.ConversationGroupID) > 0 {
+		params.Set("conversationGroupId", options.ConversationGroupID)
+	}
+	return params
+}
+
 func (c *Client) GetConversation(ctx context.Context, conversationId string) (*interfaces.Conversation, error) {
 	klog.V(6).Infof("async.GetConversation ENTER\n")
 
@@ -84,12 +248,123 @@ func (c *Client) GetConversation(ctx context.Context, conversationId string) (*i
 	if e, ok := err.(*symbl.StatusError); ok {
 		if e.Resp.StatusCode != http.StatusOK {
 			klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-			klog.V(6).Infof("async.GetConversations LEAVE\n")
+			klog.V(6).Infof("async.GetConversation LEAVE\n")
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetConversation LEAVE\n")
+		return nil, err
+	}
 
-	klog.V(3).Infof("GET Conversations succeeded\n")
-	klog.V(6).Infof("async.GetConversations LEAVE\n")
+	klog.V(3).Infof("GET Conversation succeeded\n")
+	klog.V(6).Infof("async.GetConversation LEAVE\n")
+	return &result, nil
+}
+
+// UpdateConversation changes the name and/or metadata of a conversation
+func (c *Client) UpdateConversation(ctx context.Context, conversationId string, request interfaces.UpdateConversationRequest) (*interfaces.Conversation, error) {
+	klog.V(6).Infof("async.UpdateConversation ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if conversationId == "" {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if len(request.Name) == 0 && len(request.Metadata) == 0 {
+		klog.V(1).Infof("UpdateConversationRequest contains no changes\n")
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	// request
+	URI := version.GetAsyncAPI(version.ConversationURI, conversationId)
+	klog.V(6).Infof("Calling %s\n", URI)
+
+	jsonStr, err := json.Marshal(request)
+	if err != nil {
+		klog.V(1).Infof("json.Marshal failed. Err: %v\n", err)
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", URI, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, err
+	}
+
+	// check the status
+	var result interfaces.Conversation
+
+	err = c.Client.Do(ctx, req, &result)
+
+	if e, ok := err.(*symbl.StatusError); ok {
+		if e.Resp.StatusCode != http.StatusOK {
+			klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
+			klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+			return nil, err
+		}
+	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("PATCH Conversation succeeded\n")
+	klog.V(6).Infof("async.UpdateConversation LEAVE\n")
 	return &result, nil
 }
+
+// DeleteConversation permanently removes a conversation and all of its insights
+func (c *Client) DeleteConversation(ctx context.Context, conversationId string) error {
+	klog.V(6).Infof("async.DeleteConversation ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if conversationId == "" {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+		return ErrInvalidInput
+	}
+
+	// request
+	URI := version.GetAsyncAPI(version.ConversationURI, conversationId)
+	klog.V(6).Infof("Calling %s\n", URI)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", URI, nil)
+	if err != nil {
+		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
+		klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+		return err
+	}
+
+	// check the status
+	err = c.Client.Do(ctx, req, nil)
+
+	if e, ok := err.(*symbl.StatusError); ok {
+		if e.Resp.StatusCode != http.StatusOK {
+			klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
+			klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+			return err
+		}
+	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+		return err
+	}
+
+	klog.V(3).Infof("DELETE Conversation succeeded\n")
+	klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+	return nil
+}
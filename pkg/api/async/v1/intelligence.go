@@ -51,6 +51,11 @@ func (c *Client) GetTopics(ctx context.Context, conversationId string) (*interfa
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetTopics LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Topics succeeded\n")
 	klog.V(6).Infof("async.GetTopics LEAVE\n")
@@ -93,6 +98,11 @@ func (c *Client) GetQuestions(ctx context.Context, conversationId string) (*inte
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetQuestions LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Questions succeeded\n")
 	klog.V(6).Infof("async.GetQuestions LEAVE\n")
@@ -135,6 +145,11 @@ func (c *Client) GetFollowUps(ctx context.Context, conversationId string) (*inte
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetFollowUps LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Follow Ups succeeded\n")
 	klog.V(6).Infof("async.GetFollowUps LEAVE\n")
@@ -177,6 +192,11 @@ func (c *Client) GetEntities(ctx context.Context, conversationId string) (*inter
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetEntities LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Entities succeeded\n")
 	klog.V(6).Infof("async.GetEntities LEAVE\n")
@@ -219,6 +239,11 @@ func (c *Client) GetActionItems(ctx context.Context, conversationId string) (*in
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetActionItems LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Action Items succeeded\n")
 	klog.V(6).Infof("async.GetActionItems LEAVE\n")
@@ -261,6 +286,11 @@ func (c *Client) GetMessages(ctx context.Context, conversationId string) (*inter
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetMessages LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Messages succeeded\n")
 	klog.V(6).Infof("async.GetMessages LEAVE\n")
@@ -303,6 +333,11 @@ func (c *Client) GetSummary(ctx context.Context, conversationId string) (*interf
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetSummary LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Summary succeeded\n")
 	klog.V(6).Infof("async.GetSummary LEAVE\n")
@@ -345,6 +380,11 @@ func (c *Client) GetAnalytics(ctx context.Context, conversationId string) (*inte
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetAnalytics LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Analytics succeeded\n")
 	klog.V(6).Infof("async.GetAnalytics LEAVE\n")
@@ -387,6 +427,11 @@ func (c *Client) GetTracker(ctx context.Context, conversationId string) (*interf
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetTracker LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Tracker succeeded\n")
 	klog.V(6).Infof("async.GetTracker LEAVE\n")
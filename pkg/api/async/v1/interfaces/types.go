@@ -3,6 +3,12 @@
 
 package interfaces
 
+import (
+	"encoding/json"
+
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
 /*
 	Shared definitions
 */
@@ -18,8 +24,23 @@ type From struct {
 }
 
 type Duration struct {
-	StartTime string `json:"startTime,omitempty"`
-	EndTime   string `json:"endTime,omitempty"`
+	StartTime common.Timestamp `json:"startTime,omitempty"`
+	EndTime   common.Timestamp `json:"endTime,omitempty"`
+}
+
+// MarshalJSON leaves out zero times, which omitempty can't do for a struct
+func (d Duration) MarshalJSON() ([]byte, error) {
+	var out struct {
+		StartTime *common.Timestamp `json:"startTime,omitempty"`
+		EndTime   *common.Timestamp `json:"endTime,omitempty"`
+	}
+	if !d.StartTime.IsZero() || len(d.StartTime.Raw()) > 0 {
+		out.StartTime = &d.StartTime
+	}
+	if !d.EndTime.IsZero() || len(d.EndTime.Raw()) > 0 {
+		out.EndTime = &d.EndTime
+	}
+	return json.Marshal(out)
 }
 
 type Metric struct {
@@ -36,11 +57,11 @@ type Sentiment struct {
 }
 
 type MessageRef struct {
-	ID        string `json:"id,omitempty"`
-	StartTime string `json:"startTime,omitempty"`
-	EndTime   string `json:"endTime,omitempty"`
-	Text      string `json:"text,omitempty"`
-	Offset    int    `json:"offset,omitempty"`
+	ID        string           `json:"id,omitempty"`
+	StartTime common.Timestamp `json:"startTime,omitempty"`
+	EndTime   common.Timestamp `json:"endTime,omitempty"`
+	Text      string           `json:"text,omitempty"`
+	Offset    int              `json:"offset,omitempty"`
 }
 
 type ParentRef struct {
@@ -84,18 +105,19 @@ type Entity struct {
 	When exercising the API and description is blank...
 
 	HTTP Code: 400
+
 	{
 		"message":"\"description\" is not allowed to be empty"
 	}
 */
 type Bookmark struct {
-	ID              string       `json:"id,omitempty"`
-	Label           string       `json:"label,omitempty" validate:"required"`
-	Description     string       `json:"description,omitempty" validate:"required"` // please see note above
-	User            User         `json:"user,omitempty" validate:"required"`
-	BeginTimeOffset int          `json:"beginTimeOffset,omitempty" validate:"required"`
-	Duration        int          `json:"duration,omitempty" validate:"required"`
-	MessageRefs     []MessageRef `json:"messageRefs,omitempty" validate:"required"`
+	ID              string         `json:"id,omitempty"`
+	Label           string         `json:"label,omitempty" validate:"required"`
+	Description     string         `json:"description,omitempty" validate:"required"` // please see note above
+	User            User           `json:"user,omitempty" validate:"required"`
+	BeginTimeOffset common.Seconds `json:"beginTimeOffset,omitempty"`
+	Duration        common.Seconds `json:"duration,omitempty"`
+	MessageRefs     []MessageRef   `json:"messageRefs,omitempty"`
 }
 
 type Topic struct {
@@ -150,33 +172,33 @@ type ActionItem struct {
 }
 
 type Message struct {
-	ID             string    `json:"id,omitempty"`
-	Text           string    `json:"text,omitempty"`
-	From           From      `json:"from,omitempty"`
-	StartTime      string    `json:"startTime,omitempty"`
-	EndTime        string    `json:"endTime,omitempty"`
-	TimeOffset     float64   `json:"timeOffset,omitempty"`
-	Duration       float64   `json:"duration,omitempty"`
-	ConversationID string    `json:"conversationId,omitempty"`
-	Phrases        []string  `json:"phrases,omitempty"` // TODO: I believe this is []string. Need to validate.
-	Sentiment      Sentiment `json:"sentiment,omitempty"`
+	ID             string           `json:"id,omitempty"`
+	Text           string           `json:"text,omitempty"`
+	From           From             `json:"from,omitempty"`
+	StartTime      common.Timestamp `json:"startTime,omitempty"`
+	EndTime        common.Timestamp `json:"endTime,omitempty"`
+	TimeOffset     common.Seconds   `json:"timeOffset,omitempty"`
+	Duration       common.Seconds   `json:"duration,omitempty"`
+	ConversationID string           `json:"conversationId,omitempty"`
+	Phrases        []string         `json:"phrases,omitempty"` // TODO: I believe this is []string. Need to validate.
+	Sentiment      Sentiment        `json:"sentiment,omitempty"`
 	Words          []struct {
-		Word       string  `json:"word,omitempty"`
-		StartTime  string  `json:"startTime,omitempty"`
-		EndTime    string  `json:"endTime,omitempty"`
-		SpeakerTag int     `json:"speakerTag,omitempty"`
-		Score      float64 `json:"score,omitempty"`
-		TimeOffset float64 `json:"timeOffset,omitempty"`
-		Duration   float64 `json:"duration,omitempty"`
+		Word       string           `json:"word,omitempty"`
+		StartTime  common.Timestamp `json:"startTime,omitempty"`
+		EndTime    common.Timestamp `json:"endTime,omitempty"`
+		SpeakerTag int              `json:"speakerTag,omitempty"`
+		Score      float64          `json:"score,omitempty"`
+		TimeOffset common.Seconds   `json:"timeOffset,omitempty"`
+		Duration   common.Seconds   `json:"duration,omitempty"`
 	} `json:"words,omitempty"`
 }
 
 type Summary struct {
-	ID                string       `json:"id,omitempty"`
-	Text              string       `json:"text,omitempty"`
-	MessageRefs       []MessageRef `json:"messageRefs,omitempty"`
-	StartTime         string       `json:"startTime,omitempty"`
-	EndTime           string       `json:"endTime,omitempty"`
+	ID                string           `json:"id,omitempty"`
+	Text              string           `json:"text,omitempty"`
+	MessageRefs       []MessageRef     `json:"messageRefs,omitempty"`
+	StartTime         common.Timestamp `json:"startTime,omitempty"`
+	EndTime           common.Timestamp `json:"endTime,omitempty"`
 	BookmarkReference struct {
 		ID string `json:"id"`
 	} `json:"bookmarkReference"`
@@ -197,28 +219,41 @@ type Member struct {
 		Percentage float64 `json:"percentage,omitempty"`
 		Seconds    float64 `json:"seconds,omitempty"`
 	} `json:"listenTime,omitempty"`
-	Overlap struct {
-	} `json:"overlap,omitempty"` // TODO: need to revisit this
+	Overlap Overlap `json:"overlap,omitempty"`
+}
+
+// Overlap is the time a member spent talking over other members
+type Overlap struct {
+	Percentage         float64             `json:"percentage,omitempty"`
+	Seconds            float64             `json:"seconds,omitempty"`
+	OverlappingMembers []OverlappingMember `json:"overlappingMembers,omitempty"`
+}
+
+type OverlappingMember struct {
+	ID         string  `json:"id,omitempty"`
+	Name       string  `json:"name,omitempty"`
+	Email      string  `json:"email,omitempty"`
+	Percentage float64 `json:"percentage,omitempty"`
+	Seconds    float64 `json:"seconds,omitempty"`
 }
 
 type Conversation struct {
-	ID        string   `json:"id,omitempty"`
-	Type      string   `json:"type,omitempty"`
-	Name      string   `json:"name,omitempty"`
-	StartTime string   `json:"startTime,omitempty"`
-	EndTime   string   `json:"endTime,omitempty"`
-	Members   []Member `json:"members,omitempty"`
-	Metadata  struct {
-	} `json:"metadata,omitempty"` // TODO: need to revisit this
+	ID        string           `json:"id,omitempty"`
+	Type      string           `json:"type,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	StartTime common.Timestamp `json:"startTime,omitempty"`
+	EndTime   common.Timestamp `json:"endTime,omitempty"`
+	Members   []Member         `json:"members,omitempty"`
+	Metadata  Metadata         `json:"metadata,omitempty"`
 }
 
+// Metadata is the custom key/value data attached to a conversation
+type Metadata map[string]interface{}
+
 type SpeakerEvent struct {
-	Type   string `json:"type,omitempty"`
-	User   Member `json:"user,omitempty"`
-	Offset struct {
-		Seconds int `json:"seconds,omitempty"`
-		Nanos   int `json:"nanos,omitempty"`
-	} `json:"offset,omitempty"`
+	Type   string               `json:"type,omitempty"`
+	User   Member               `json:"user,omitempty"`
+	Offset common.ProtoDuration `json:"offset"`
 }
 
 type BookmarksSummary struct {
@@ -264,6 +299,17 @@ type AsyncOptions struct {
 	Sentiment                           bool    `json:"sentiment,omitempty"`
 }
 
+// ProgressCallback reports the bytes uploaded so far. total is zero when the length is unknown.
+type ProgressCallback func(uploaded, total int64)
+
+// ReaderOptions describes media uploaded from an io.Reader instead of a file on disk
+type ReaderOptions struct {
+	Name          string // name of the media, defaults to AsyncOptions.Name
+	ContentType   string // MIME type, audio/* uses the audio endpoint and everything else video
+	ContentLength int64  // size in bytes if known
+	Progress      ProgressCallback
+}
+
 type AsyncTextRequest struct {
 	Messages            []TextMessage `json:"messages,omitempty" validate:"required"`
 	Name                string        `json:"name,omitempty"`
@@ -290,8 +336,8 @@ type BookmarkRequest struct {
 	Label           string              `json:"label,omitempty" validate:"required"`
 	Description     string              `json:"description,omitempty" validate:"required"`
 	User            User                `json:"user,omitempty" validate:"required"`
-	BeginTimeOffset int                 `json:"beginTimeOffset,omitempty"`
-	Duration        int                 `json:"duration,omitempty"`
+	BeginTimeOffset common.WholeSeconds `json:"beginTimeOffset"`
+	Duration        common.WholeSeconds `json:"duration"`
 	MessageRefs     []MessageRefRequest `json:"messageRefs,omitempty"`
 }
 
@@ -313,6 +359,12 @@ type UpdateSpeakerRequest struct {
 	SpeakerEvents []SpeakerEvent `json:"speakerEvents"`
 }
 
+// UpdateConversationRequest changes the name and/or metadata of a conversation
+type UpdateConversationRequest struct {
+	Name     string   `json:"name,omitempty"`
+	Metadata Metadata `json:"metadata,omitempty"`
+}
+
 /*
 	Output parameters for Async API calls
 */
@@ -366,6 +418,7 @@ type SummaryUIResult struct {
 
 type ConversationsResult struct {
 	Conversations []Conversation `json:"conversations"`
+	Next          string         `json:"next,omitempty"`
 }
 
 type MembersResult struct {
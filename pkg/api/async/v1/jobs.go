@@ -0,0 +1,190 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package async
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	validator "gopkg.in/go-playground/validator.v9"
+	klog "k8s.io/klog/v2"
+
+	version "github.com/dvonthenen/symbl-go-sdk/pkg/api/version"
+	symbl "github.com/dvonthenen/symbl-go-sdk/pkg/client"
+)
+
+const (
+	defaultInitialDelay time.Duration = 2 * time.Second
+	defaultMaxDelay     time.Duration = 30 * time.Second
+	defaultMultiplier   float64       = 1.5
+)
+
+func (c *Client) GetJobStatus(ctx context.Context, jobId string) (*JobStatus, error) {
+	klog.V(6).Infof("async.GetJobStatus ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if jobId == "" {
+		klog.V(1).Infof("jobId is empty\n")
+		klog.V(6).Infof("async.GetJobStatus LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	// request
+	URI := version.GetAsyncAPI(version.JobStatusURI, jobId)
+	klog.V(6).Infof("Calling %s\n", URI)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
+	if err != nil {
+		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetJobStatus LEAVE\n")
+		return nil, err
+	}
+
+	// check the status
+	var result JobStatus
+
+	err = c.Client.Do(ctx, req, &result)
+
+	if e, ok := err.(*symbl.StatusError); ok {
+		if e.Resp.StatusCode != http.StatusOK {
+			klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
+			klog.V(6).Infof("async.GetJobStatus LEAVE\n")
+			return nil, err
+		}
+	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetJobStatus LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("GET JobStatus succeeded: %s\n", result.Status)
+	klog.V(6).Infof("async.GetJobStatus LEAVE\n")
+	return &result, nil
+}
+
+// PollJobStatus checks the job until it is completed or failed, backing off between checks.
+// It stops when ctx is done or the optional Timeout expires. The last status seen is always
+// returned, with ErrJobFailed when the platform failed the job or ErrJobStatusTimeout when
+// the deadline was reached first.
+func (c *Client) PollJobStatus(ctx context.Context, opts JobPollOptions) (*JobStatus, error) {
+	klog.V(6).Infof("async.PollJobStatus ENTER\n")
+
+	// validate input
+	v := validator.New()
+	err := v.Struct(opts)
+	if err != nil {
+		for _, e := range err.(validator.ValidationErrors) {
+			klog.V(1).Infof("PollJobStatus validation failed: %v\n", e)
+		}
+		klog.V(6).Infof("async.PollJobStatus LEAVE\n")
+		return nil, err
+	}
+	if opts.Timeout < 0 || opts.InitialDelay < 0 || opts.MaxDelay < 0 {
+		klog.V(1).Infof("Invalid wait interval\n")
+		klog.V(6).Infof("async.PollJobStatus LEAVE\n")
+		return nil, ErrInvalidWaitTime
+	}
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	delay := opts.InitialDelay
+	if delay == 0 {
+		delay = defaultInitialDelay
+	}
+	maxDelay := opts.MaxDelay
+	if maxDelay == 0 {
+		maxDelay = defaultMaxDelay
+	}
+	multiplier := opts.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultMultiplier
+	}
+
+	var last *JobStatus
+	var history []JobStatusTransition
+
+	for {
+		current, err := c.GetJobStatus(ctx, opts.JobId)
+		if err != nil {
+			if ctx.Err() != nil {
+				klog.V(1).Infof("job status check interrupted. Err: %v\n", ctx.Err())
+				klog.V(6).Infof("async.PollJobStatus LEAVE\n")
+				return withHistory(last, history), pollContextError(ctx)
+			}
+			klog.V(1).Infof("GetJobStatus failed. Err: %v\n", err)
+			klog.V(6).Infof("async.PollJobStatus LEAVE\n")
+			return withHistory(last, history), err
+		}
+
+		// record transitions
+		if last == nil || last.Status != current.Status {
+			klog.V(4).Infof("Job %s status: %s\n", opts.JobId, current.Status)
+			history = append(history, JobStatusTransition{
+				Status: current.Status,
+				Time:   time.Now(),
+			})
+			if opts.Callback != nil {
+				opts.Callback(last, current)
+			}
+		}
+		last = current
+
+		switch current.Status {
+		case JobStatusComplete:
+			klog.V(3).Info("PollJobStatus completed!\n")
+			klog.V(6).Infof("async.PollJobStatus LEAVE\n")
+			return withHistory(last, history), nil
+		case JobStatusFailed:
+			klog.V(1).Infof("Job %s failed: %s\n", opts.JobId, current.Message)
+			klog.V(6).Infof("async.PollJobStatus LEAVE\n")
+			return withHistory(last, history), ErrJobFailed
+		}
+
+		// delay before next check
+		klog.V(5).Infof("Sleep %v for retry...\n", delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			klog.V(1).Infof("job status check interrupted. Err: %v\n", ctx.Err())
+			klog.V(6).Infof("async.PollJobStatus LEAVE\n")
+			return withHistory(last, history), pollContextError(ctx)
+		case <-timer.C:
+		}
+
+		delay = time.Duration(float64(delay) * multiplier)
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+func withHistory(status *JobStatus, history []JobStatusTransition) *JobStatus {
+	if status == nil {
+		return nil
+	}
+	status.History = history
+	return status
+}
+
+func pollContextError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ErrJobStatusTimeout
+	}
+	return ctx.Err()
+}
@@ -53,6 +53,11 @@ func (c *Client) GetMembers(ctx context.Context, conversationId string) (*interf
 			return nil, err
 		}
 	}
+	if err != nil {
+		klog.V(1).Infof("Client.Do failed. Err: %v\n", err)
+		klog.V(6).Infof("async.GetMembers LEAVE\n")
+		return nil, err
+	}
 
 	klog.V(3).Infof("GET Members succeeded\n")
 	klog.V(6).Infof("async.GetMembers LEAVE\n")
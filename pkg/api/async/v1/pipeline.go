@@ -0,0 +1,248 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package async
+
+import (
+	"context"
+	"sync"
+
+	klog "k8s.io/klog/v2"
+)
+
+// ProcessConversation submits a file, URL or text conversation, waits for the job to complete
+// and then fetches the requested insights concurrently. When only some insights could be
+// fetched, the partial result is returned along with ErrPartialInsights.
+func (c *Client) ProcessConversation(ctx context.Context, request ProcessRequest) (*ConversationInsights, error) {
+	klog.V(6).Infof("async.ProcessConversation ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	inputs := 0
+	if len(request.FilePath) > 0 {
+		inputs++
+	}
+	if len(request.Options.URL) > 0 {
+		inputs++
+	}
+	if request.Text != nil {
+		inputs++
+	}
+	if inputs != 1 {
+		klog.V(1).Infof("Exactly one of FilePath, Options.URL or Text is required\n")
+		klog.V(6).Infof("async.ProcessConversation LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	// submit
+	var jobConvo *JobConversation
+	var err error
+	switch {
+	case len(request.FilePath) > 0:
+		jobConvo, err = c.PostFileWithOptions(ctx, request.FilePath, request.Options)
+	case len(request.Options.URL) > 0:
+		jobConvo, err = c.PostURLWithOptions(ctx, request.Options)
+	default:
+		jobConvo, err = c.PostTextWithOptions(ctx, *request.Text)
+	}
+	if err != nil {
+		klog.V(1).Infof("Post failed. Err: %v\n", err)
+		klog.V(6).Infof("async.ProcessConversation LEAVE\n")
+		return nil, err
+	}
+	if jobConvo == nil || len(jobConvo.JobID) == 0 {
+		klog.V(1).Infof("Post did not return a job\n")
+		klog.V(6).Infof("async.ProcessConversation LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	klog.V(4).Infof("JobID: %s, ConversationID: %s\n", jobConvo.JobID, jobConvo.ConversationID)
+
+	// wait
+	pollOpts := request.Poll
+	pollOpts.JobId = jobConvo.JobID
+
+	_, err = c.PollJobStatus(ctx, pollOpts)
+	if err != nil {
+		klog.V(1).Infof("PollJobStatus failed. Err: %v\n", err)
+		klog.V(6).Infof("async.ProcessConversation LEAVE\n")
+		return &ConversationInsights{
+			JobID:          jobConvo.JobID,
+			ConversationID: jobConvo.ConversationID,
+		}, err
+	}
+
+	// fetch
+	insights, err := c.FetchInsights(ctx, jobConvo.ConversationID, request.Insights)
+	if insights != nil {
+		insights.JobID = jobConvo.JobID
+	}
+	if err != nil {
+		klog.V(1).Infof("FetchInsights failed. Err: %v\n", err)
+		klog.V(6).Infof("async.ProcessConversation LEAVE\n")
+		return insights, err
+	}
+
+	klog.V(3).Infof("async.ProcessConversation Succeeded\n")
+	klog.V(6).Infof("async.ProcessConversation LEAVE\n")
+	return insights, nil
+}
+
+// FetchInsights retrieves the requested insights for a processed conversation concurrently.
+// When insights is empty, DefaultInsights are fetched. When only some insights could be
+// fetched, the partial result is returned along with ErrPartialInsights.
+func (c *Client) FetchInsights(ctx context.Context, conversationId string, insights []string) (*ConversationInsights, error) {
+	klog.V(6).Infof("async.FetchInsights ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if conversationId == "" {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.FetchInsights LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if len(insights) == 0 {
+		insights = DefaultInsights
+	}
+	for _, insight := range insights {
+		if !isSupportedInsight(insight) {
+			klog.V(1).Infof("Invalid insight type: %s\n", insight)
+			klog.V(6).Infof("async.FetchInsights LEAVE\n")
+			return nil, ErrInvalidInsightType
+		}
+	}
+
+	result := &ConversationInsights{
+		ConversationID: conversationId,
+		Errors:         make(map[string]error),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, insight := range insights {
+		wg.Add(1)
+		go func(insight string) {
+			defer wg.Done()
+
+			err := c.fetchInsight(ctx, conversationId, insight, result, &mu)
+			if err != nil {
+				klog.V(1).Infof("Fetching %s failed. Err: %v\n", insight, err)
+				mu.Lock()
+				result.Errors[insight] = err
+				mu.Unlock()
+			}
+		}(insight)
+	}
+	wg.Wait()
+
+	if len(result.Errors) > 0 {
+		klog.V(1).Infof("%d of %d insights failed\n", len(result.Errors), len(insights))
+		klog.V(6).Infof("async.FetchInsights LEAVE\n")
+		return result, ErrPartialInsights
+	}
+
+	klog.V(3).Infof("async.FetchInsights Succeeded\n")
+	klog.V(6).Infof("async.FetchInsights LEAVE\n")
+	return result, nil
+}
+
+func (c *Client) fetchInsight(ctx context.Context, conversationId, insight string, result *ConversationInsights, mu *sync.Mutex) error {
+	switch insight {
+	case InsightMessages:
+		r, err := c.GetMessages(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.Messages = r
+			mu.Unlock()
+		}
+		return err
+	case InsightTopics:
+		r, err := c.GetTopics(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.Topics = r
+			mu.Unlock()
+		}
+		return err
+	case InsightQuestions:
+		r, err := c.GetQuestions(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.Questions = r
+			mu.Unlock()
+		}
+		return err
+	case InsightActionItems:
+		r, err := c.GetActionItems(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.ActionItems = r
+			mu.Unlock()
+		}
+		return err
+	case InsightFollowUps:
+		r, err := c.GetFollowUps(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.FollowUps = r
+			mu.Unlock()
+		}
+		return err
+	case InsightEntities:
+		r, err := c.GetEntities(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.Entities = r
+			mu.Unlock()
+		}
+		return err
+	case InsightSummary:
+		r, err := c.GetSummary(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.Summary = r
+			mu.Unlock()
+		}
+		return err
+	case InsightAnalytics:
+		r, err := c.GetAnalytics(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.Analytics = r
+			mu.Unlock()
+		}
+		return err
+	case InsightTrackers:
+		r, err := c.GetTracker(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.Trackers = r
+			mu.Unlock()
+		}
+		return err
+	case InsightMembers:
+		r, err := c.GetMembers(ctx, conversationId)
+		if err == nil {
+			mu.Lock()
+			result.Members = r
+			mu.Unlock()
+		}
+		return err
+	}
+
+	return ErrInvalidInsightType
+}
+
+func isSupportedInsight(insight string) bool {
+	for _, supported := range AllInsights {
+		if insight == supported {
+			return true
+		}
+	}
+	return false
+}
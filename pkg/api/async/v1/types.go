@@ -3,13 +3,186 @@
 
 package async
 
+import (
+	"context"
+	"sync"
+	"time"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+/*
+	Input structs for API calls
+*/
+// JobStatusCallback is notified each time a polled job changes status
+type JobStatusCallback func(previous, current *JobStatus)
+
+// JobPollOptions controls how PollJobStatus waits for a job to reach a terminal state
+type JobPollOptions struct {
+	JobId string `validate:"required"`
+
+	// Timeout bounds the total wait in addition to any deadline on ctx. Zero means ctx only.
+	Timeout time.Duration
+	// InitialDelay between the first and second check, defaults to 2s
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between checks, defaults to 30s
+	MaxDelay time.Duration
+	// Multiplier applied to the delay after each check, defaults to 1.5. Use 1 for a fixed interval.
+	Multiplier float64
+
+	Callback JobStatusCallback
+}
+
+// ProcessRequest is the input to ProcessConversation. Exactly one of FilePath, Options.URL
+// or Text must be provided.
+type ProcessRequest struct {
+	FilePath string
+	Options  interfaces.AsyncOptions
+	Text     *interfaces.AsyncTextRequest
+
+	// Insights to fetch once processing completes, defaults to DefaultInsights
+	Insights []string
+	// Poll controls waiting for the job. JobId is filled in automatically.
+	Poll JobPollOptions
+}
+
+// TextChunkOptions controls how PostTextChunked and PostAppendTextChunked split a large request
+type TextChunkOptions struct {
+	// MaxMessages per request, defaults to 500
+	MaxMessages int
+	// MaxBytes of the encoded messages per request, defaults to 512KB
+	MaxBytes int
+
+	// WaitForCompletion also waits for the job of the final chunk. Jobs of earlier chunks are
+	// always waited on before the next chunk is appended.
+	WaitForCompletion bool
+	// Poll controls waiting for each job. JobId is filled in automatically and Timeout
+	// defaults to 10 minutes per job.
+	Poll JobPollOptions
+}
+
+// ConversationListOptions filters and pages the conversations returned by GetConversationsWithOptions
+type ConversationListOptions struct {
+	// Limit the number of conversations per page, defaults to the platform default
+	Limit int
+	// Offset of the first conversation to return
+	Offset int
+	// Cursor continues from a previous page and takes precedence over Offset
+	Cursor string
+	// Order is OrderAscending or OrderDescending
+	Order string
+	// Sort is the field to sort on, for example conversation.startTime
+	Sort string
+
+	// StartTime and EndTime restrict the results to conversations in the date range
+	StartTime time.Time
+	EndTime   time.Time
+
+	// GroupID and ConversationGroupID restrict the results to a group
+	GroupID             string
+	ConversationGroupID string
+}
+
+// ConversationIterator walks every page of GetConversationsWithOptions
+type ConversationIterator struct {
+	client  *Client
+	ctx     context.Context
+	options ConversationListOptions
+
+	page    []interfaces.Conversation
+	firstID string // first conversation of the previous page
+	index   int
+	current *interfaces.Conversation
+	done    bool
+	err     error
+}
+
+// BatchItem is a single file or URL to process with ProcessBatch
+type BatchItem struct {
+	// ID uniquely identifies the item in the report and progress state, defaults to FilePath or Options.URL
+	ID       string
+	FilePath string
+	Options  interfaces.AsyncOptions
+}
+
+// BatchCallback is notified as each batch item finishes
+type BatchCallback func(result *BatchItemResult)
+
+// BatchOptions configures ProcessBatch
+type BatchOptions struct {
+	// Workers processing items in parallel, defaults to 4
+	Workers int
+	// RequestsPerSecond limits submissions across all workers. Zero means unlimited.
+	RequestsPerSecond float64
+	// MaxRetries per item after the first attempt, defaults to 2. Use a negative value for none.
+	MaxRetries int
+	// RetryDelay before the first retry, doubled for each subsequent retry. Defaults to 5s.
+	RetryDelay time.Duration
+
+	// WaitForCompletion polls each job until the platform finishes processing it
+	WaitForCompletion bool
+	// Poll controls waiting for each job. JobId is filled in automatically.
+	Poll JobPollOptions
+
+	// StatePath persists progress so an interrupted batch can be resumed. Items which already
+	// succeeded in a previous run are skipped and items with a submitted job resume polling it.
+	StatePath string
+
+	Callback BatchCallback
+}
+
+// BookmarkUpdate is a single update applied by UpdateBookmarks
+type BookmarkUpdate struct {
+	BookmarkId string `validate:"required"`
+	Request    interfaces.BookmarkRequest
+}
+
+// WebhookCallback receives verified webhook events
+type WebhookCallback func(event *WebhookEvent)
+
+// WebhookOptions configures the WebhookHandler
+type WebhookOptions struct {
+	// Token, when set, must be present as the WebhookTokenParam query parameter of the request.
+	// Use WebhookURLWithToken to build the WebhookURL given to the platform.
+	Token string
+	// VerifyWithPlatform confirms the status by querying the job API before delivering
+	VerifyWithPlatform bool
+	// AcceptUnknownJobs delivers events for jobs that were never registered
+	AcceptUnknownJobs bool
+
+	// Callback receives events. When nil, events are delivered on the Events channel.
+	Callback WebhookCallback
+	// ChannelSize of the Events channel, defaults to 100
+	ChannelSize int
+}
+
+// WebhookHandler is an http.Handler receiving job status webhooks from the platform
+type WebhookHandler struct {
+	client  *Client
+	options WebhookOptions
+	events  chan *WebhookEvent
+
+	mu      sync.Mutex
+	pending map[string]*JobConversation
+}
+
 /*
 	Output structs for API calls
 */
 // JobStatus captures the API for getting status
 type JobStatus struct {
-	ID     string `json:"id"`
-	Status string `json:"status"`
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+
+	// History of status transitions observed by PollJobStatus
+	History []JobStatusTransition `json:"-"`
+}
+
+// JobStatusTransition records when a polled job was first seen in a status
+type JobStatusTransition struct {
+	Status string
+	Time   time.Time
 }
 
 // JobConversation represents processing an Async API request
@@ -17,3 +190,56 @@ type JobConversation struct {
 	JobID          string `json:"jobId"`
 	ConversationID string `json:"conversationId"`
 }
+
+// WebhookPayload is the body the platform posts to the WebhookURL
+type WebhookPayload struct {
+	JobID  string `json:"jobId"`
+	Status string `json:"status"`
+}
+
+// WebhookEvent is a verified job status update
+type WebhookEvent struct {
+	JobID        string
+	Status       string
+	Conversation *JobConversation // nil when the job was not registered
+	ReceivedAt   time.Time
+}
+
+// ConversationInsights collects the results of FetchInsights. Results for insights which were
+// not requested, or which failed, are nil. Failures are reported in Errors keyed by insight type.
+type ConversationInsights struct {
+	JobID          string
+	ConversationID string
+
+	Messages    *interfaces.MessageResult
+	Topics      *interfaces.TopicResult
+	Questions   *interfaces.QuestionResult
+	ActionItems *interfaces.ActionItemResult
+	FollowUps   *interfaces.FollowUpResult
+	Entities    *interfaces.EntityResult
+	Summary     *interfaces.SummaryResult
+	Analytics   *interfaces.AnalyticsResult
+	Trackers    *interfaces.TrackerResult
+	Members     *interfaces.MembersResult
+
+	Errors map[string]error
+}
+
+// BatchItemResult is the outcome of a single batch item
+type BatchItemResult struct {
+	ID             string    `json:"id"`
+	JobID          string    `json:"jobId,omitempty"`
+	ConversationID string    `json:"conversationId,omitempty"`
+	Status         string    `json:"status"`
+	Error          string    `json:"error,omitempty"`
+	Attempts       int       `json:"attempts"` // submissions to the platform
+	FinishedAt     time.Time `json:"finishedAt"`
+}
+
+// BatchReport maps each batch item ID to its result
+type BatchReport struct {
+	Results   map[string]*BatchItemResult `json:"results"`
+	Succeeded int                         `json:"succeeded"`
+	Failed    int                         `json:"failed"`
+	Resumed   int                         `json:"resumed"`
+}
@@ -0,0 +1,215 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package async
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	klog "k8s.io/klog/v2"
+)
+
+const (
+	defaultWebhookChannelSize int   = 100
+	maxWebhookBodySize        int64 = 1 << 20
+)
+
+// NewWebhookHandler creates an http.Handler for job status webhooks. The client is required
+// when VerifyWithPlatform is set and may otherwise be nil.
+func NewWebhookHandler(client *Client, options WebhookOptions) (*WebhookHandler, error) {
+	if options.VerifyWithPlatform && client == nil {
+		klog.V(1).Infof("VerifyWithPlatform requires a client\n")
+		return nil, ErrInvalidInput
+	}
+
+	h := &WebhookHandler{
+		client:  client,
+		options: options,
+		pending: make(map[string]*JobConversation),
+	}
+
+	if options.Callback == nil {
+		size := options.ChannelSize
+		if size <= 0 {
+			size = defaultWebhookChannelSize
+		}
+		h.events = make(chan *WebhookEvent, size)
+	}
+
+	return h, nil
+}
+
+// WebhookURLWithToken appends the token to baseURL so it can be used as the WebhookURL
+// in AsyncOptions or AsyncTextRequest
+func WebhookURLWithToken(baseURL, token string) (string, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		klog.V(1).Infof("url.Parse failed. Err: %v\n", err)
+		return "", err
+	}
+
+	query := u.Query()
+	query.Set(WebhookTokenParam, token)
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
+
+// Register tracks a submitted job so webhook events can be correlated to it
+func (h *WebhookHandler) Register(job *JobConversation) error {
+	if job == nil || len(job.JobID) == 0 {
+		klog.V(1).Infof("job is invalid\n")
+		return ErrInvalidInput
+	}
+
+	h.mu.Lock()
+	h.pending[job.JobID] = job
+	h.mu.Unlock()
+
+	klog.V(4).Infof("Registered job %s\n", job.JobID)
+	return nil
+}
+
+// Unregister stops tracking a job
+func (h *WebhookHandler) Unregister(jobId string) {
+	h.mu.Lock()
+	delete(h.pending, jobId)
+	h.mu.Unlock()
+}
+
+// Pending returns the jobs which have not reached a terminal status
+func (h *WebhookHandler) Pending() []JobConversation {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	jobs := make([]JobConversation, 0, len(h.pending))
+	for _, job := range h.pending {
+		jobs = append(jobs, *job)
+	}
+	return jobs
+}
+
+// Events delivers webhook events when no Callback is configured
+func (h *WebhookHandler) Events() <-chan *WebhookEvent {
+	return h.events
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	klog.V(6).Infof("async.WebhookHandler ENTER\n")
+
+	if r.Method != http.MethodPost {
+		klog.V(1).Infof("Invalid method: %s\n", r.Method)
+		klog.V(6).Infof("async.WebhookHandler LEAVE\n")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	event, err := h.parse(r)
+	switch err {
+	case nil:
+	case ErrWebhookUnauthorized:
+		klog.V(6).Infof("async.WebhookHandler LEAVE\n")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	case ErrWebhookUnknownJob:
+		klog.V(6).Infof("async.WebhookHandler LEAVE\n")
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case ErrInvalidInput:
+		klog.V(6).Infof("async.WebhookHandler LEAVE\n")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	default:
+		klog.V(6).Infof("async.WebhookHandler LEAVE\n")
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	// job is done, stop tracking
+	if event.Status == JobStatusComplete || event.Status == JobStatusFailed {
+		h.Unregister(event.JobID)
+	}
+
+	// deliver
+	if h.options.Callback != nil {
+		h.options.Callback(event)
+	} else {
+		select {
+		case h.events <- event:
+		case <-r.Context().Done():
+			klog.V(1).Infof("Event for job %s dropped. Err: %v\n", event.JobID, r.Context().Err())
+			klog.V(6).Infof("async.WebhookHandler LEAVE\n")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	klog.V(3).Infof("Webhook for job %s delivered. Status: %s\n", event.JobID, event.Status)
+	klog.V(6).Infof("async.WebhookHandler LEAVE\n")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *WebhookHandler) parse(r *http.Request) (*WebhookEvent, error) {
+	// verify the sender
+	if len(h.options.Token) > 0 {
+		token := r.URL.Query().Get(WebhookTokenParam)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(h.options.Token)) != 1 {
+			klog.V(1).Infof("Webhook token mismatch\n")
+			return nil, ErrWebhookUnauthorized
+		}
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize))
+	if err != nil {
+		klog.V(1).Infof("io.ReadAll failed. Err: %v\n", err)
+		return nil, ErrInvalidInput
+	}
+
+	var payload WebhookPayload
+	err = json.Unmarshal(body, &payload)
+	if err != nil {
+		klog.V(1).Infof("json.Unmarshal failed. Err: %v\n", err)
+		return nil, ErrInvalidInput
+	}
+	if len(payload.JobID) == 0 || len(payload.Status) == 0 {
+		klog.V(1).Infof("Webhook payload is missing jobId or status\n")
+		return nil, ErrInvalidInput
+	}
+
+	// correlate
+	h.mu.Lock()
+	job := h.pending[payload.JobID]
+	h.mu.Unlock()
+
+	if job == nil && !h.options.AcceptUnknownJobs {
+		klog.V(1).Infof("Webhook for unknown job %s\n", payload.JobID)
+		return nil, ErrWebhookUnknownJob
+	}
+
+	event := &WebhookEvent{
+		JobID:        payload.JobID,
+		Status:       payload.Status,
+		Conversation: job,
+		ReceivedAt:   time.Now(),
+	}
+
+	// the job API is authoritative
+	if h.options.VerifyWithPlatform {
+		jobStatus, err := h.client.GetJobStatus(r.Context(), payload.JobID)
+		if err != nil {
+			klog.V(1).Infof("GetJobStatus failed. Err: %v\n", err)
+			return nil, err
+		}
+		if jobStatus.Status != payload.Status {
+			klog.V(3).Infof("Webhook status %s differs from platform status %s\n", payload.Status, jobStatus.Status)
+		}
+		event.Status = jobStatus.Status
+	}
+
+	return event, nil
+}
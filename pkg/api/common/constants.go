@@ -7,4 +7,39 @@ const (
 	AudioTypeMP3  string = "mp3"
 	AudioTypeMpeg string = "mpeg"
 	AudioTypeWav  string = "wav"
+	AudioTypeOgg  string = "ogg"
+	AudioTypeOpus string = "opus"
+	AudioTypeFlac string = "flac"
+	AudioTypeM4a  string = "m4a"
+	AudioTypeAac  string = "aac"
+	AudioTypeAmr  string = "amr"
+	AudioTypeWeba string = "weba"
+
+	VideoTypeMP4  string = "mp4"
+	VideoTypeMov  string = "mov"
+	VideoTypeWebm string = "webm"
+	VideoTypeMkv  string = "mkv"
+	VideoTypeAvi  string = "avi"
+	VideoTypeMpg  string = "mpg"
+	VideoTypeTs   string = "ts"
+	VideoTypeOgv  string = "ogv"
+
+	ContentTypeMP3       string = "audio/mpeg"
+	ContentTypeWav       string = "audio/wav"
+	ContentTypeOgg       string = "audio/ogg"
+	ContentTypeOpus      string = "audio/opus"
+	ContentTypeFlac      string = "audio/flac"
+	ContentTypeM4a       string = "audio/mp4"
+	ContentTypeAac       string = "audio/aac"
+	ContentTypeAmr       string = "audio/amr"
+	ContentTypeWebmAudio string = "audio/webm"
+
+	ContentTypeMP4       string = "video/mp4"
+	ContentTypeMov       string = "video/quicktime"
+	ContentTypeWebm      string = "video/webm"
+	ContentTypeMkv       string = "video/x-matroska"
+	ContentTypeAvi       string = "video/x-msvideo"
+	ContentTypeMpegVideo string = "video/mpeg"
+	ContentTypeTs        string = "video/mp2t"
+	ContentTypeOggVideo  string = "video/ogg"
 )
@@ -0,0 +1,62 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package common
+
+import (
+	"strings"
+)
+
+// MediaType describes a media format accepted by the async API
+type MediaType struct {
+	Extension   string
+	ContentType string
+	IsAudio     bool
+}
+
+// SupportedMediaTypes audio formats are sent to process/audio and video formats to process/video
+var SupportedMediaTypes = []MediaType{
+	{AudioTypeMP3, ContentTypeMP3, true},
+	{AudioTypeMpeg, ContentTypeMP3, true},
+	{AudioTypeWav, ContentTypeWav, true},
+	{AudioTypeOgg, ContentTypeOgg, true},
+	{AudioTypeOpus, ContentTypeOpus, true},
+	{AudioTypeFlac, ContentTypeFlac, true},
+	{AudioTypeM4a, ContentTypeM4a, true},
+	{AudioTypeAac, ContentTypeAac, true},
+	{AudioTypeAmr, ContentTypeAmr, true},
+	{AudioTypeWeba, ContentTypeWebmAudio, true},
+	{VideoTypeMP4, ContentTypeMP4, false},
+	{VideoTypeMov, ContentTypeMov, false},
+	{VideoTypeWebm, ContentTypeWebm, false},
+	{VideoTypeMkv, ContentTypeMkv, false},
+	{VideoTypeAvi, ContentTypeAvi, false},
+	{VideoTypeMpg, ContentTypeMpegVideo, false},
+	{VideoTypeTs, ContentTypeTs, false},
+	{VideoTypeOgv, ContentTypeOggVideo, false},
+}
+
+// MediaTypeByExtension looks up a supported media type by file extension, with or without the period
+func MediaTypeByExtension(extension string) (MediaType, bool) {
+	extension = strings.ToLower(strings.TrimPrefix(extension, "."))
+	for _, mediaType := range SupportedMediaTypes {
+		if mediaType.Extension == extension {
+			return mediaType, true
+		}
+	}
+	return MediaType{}, false
+}
+
+// MediaTypeByContentType looks up a supported media type by MIME type, ignoring any parameters
+func MediaTypeByContentType(contentType string) (MediaType, bool) {
+	if pos := strings.Index(contentType, ";"); pos != -1 {
+		contentType = contentType[:pos]
+	}
+	contentType = strings.ToLower(strings.TrimSpace(contentType))
+	for _, mediaType := range SupportedMediaTypes {
+		if mediaType.ContentType == contentType {
+			return mediaType, true
+		}
+	}
+	return MediaType{}, false
+}
@@ -0,0 +1,206 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+var jsonNull = []byte("null")
+
+// timestampLayouts are tried in order when decoding. Layouts without a zone are read as UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
+// Timestamp is a point in time encoded as an RFC 3339 JSON string. Empty strings and null decode
+// to the zero time, which encodes as null. A string in none of the known layouts decodes to the
+// zero time, keeps the original value in Raw and encodes back unchanged.
+type Timestamp struct {
+	time.Time
+
+	raw string
+}
+
+// NewTimestamp wraps t
+func NewTimestamp(t time.Time) Timestamp {
+	return Timestamp{Time: t}
+}
+
+// Raw returns the original value when it could not be parsed
+func (t Timestamp) Raw() string {
+	return t.raw
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		if len(t.raw) > 0 {
+			return json.Marshal(t.raw)
+		}
+		return jsonNull, nil
+	}
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	*t = Timestamp{}
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+
+	var str string
+	err := json.Unmarshal(data, &str)
+	if err != nil {
+		return err
+	}
+	if len(str) == 0 {
+		return nil
+	}
+
+	for _, layout := range timestampLayouts {
+		parsed, err := time.Parse(layout, str)
+		if err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	// an unexpected format shouldn't fail the whole response
+	t.raw = str
+	return nil
+}
+
+// Seconds is a duration encoded as a JSON number of seconds, for example 1.5. Quoted numbers
+// are also accepted when decoding.
+type Seconds time.Duration
+
+// NewSeconds converts d
+func NewSeconds(d time.Duration) Seconds {
+	return Seconds(d)
+}
+
+// Duration returns s as a time.Duration
+func (s Seconds) Duration() time.Duration {
+	return time.Duration(s)
+}
+
+func (s Seconds) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatFloat(time.Duration(s).Seconds(), 'f', -1, 64)), nil
+}
+
+func (s *Seconds) UnmarshalJSON(data []byte) error {
+	value, err := parseJSONNumber(data)
+	if err != nil {
+		return err
+	}
+	*s = Seconds(value * float64(time.Second))
+	return nil
+}
+
+// WholeSeconds is a duration encoded as a JSON integer number of seconds, rounded to the
+// nearest second, for request fields the platform expects as integers
+type WholeSeconds time.Duration
+
+// NewWholeSeconds converts d
+func NewWholeSeconds(d time.Duration) WholeSeconds {
+	return WholeSeconds(d)
+}
+
+// Duration returns w as a time.Duration
+func (w WholeSeconds) Duration() time.Duration {
+	return time.Duration(w)
+}
+
+func (w WholeSeconds) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(time.Duration(w).Round(time.Second)/time.Second), 10)), nil
+}
+
+func (w *WholeSeconds) UnmarshalJSON(data []byte) error {
+	value, err := parseJSONNumber(data)
+	if err != nil {
+		return err
+	}
+	*w = WholeSeconds(value * float64(time.Second))
+	return nil
+}
+
+// ProtoDuration is a duration encoded as a {"seconds": 1, "nanos": 500000000} JSON object.
+// The protobuf style {"seconds": "1"} sent by the streaming API is also accepted when decoding.
+type ProtoDuration time.Duration
+
+// Duration returns p as a time.Duration
+func (p ProtoDuration) Duration() time.Duration {
+	return time.Duration(p)
+}
+
+type protoDuration struct {
+	Seconds json.RawMessage `json:"seconds,omitempty"`
+	Nanos   json.RawMessage `json:"nanos,omitempty"`
+}
+
+// MarshalJSON writes numeric seconds and nanos, omitting zero fields, so a zero duration is {}
+func (p ProtoDuration) MarshalJSON() ([]byte, error) {
+	d := time.Duration(p)
+
+	return json.Marshal(struct {
+		Seconds int64 `json:"seconds,omitempty"`
+		Nanos   int64 `json:"nanos,omitempty"`
+	}{
+		Seconds: int64(d / time.Second),
+		Nanos:   int64(d % time.Second),
+	})
+}
+
+func (p *ProtoDuration) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*p = 0
+		return nil
+	}
+
+	var raw protoDuration
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	seconds, err := parseJSONNumber(raw.Seconds)
+	if err != nil {
+		return err
+	}
+	nanos, err := parseJSONNumber(raw.Nanos)
+	if err != nil {
+		return err
+	}
+
+	*p = ProtoDuration(time.Duration(seconds)*time.Second + time.Duration(nanos))
+	return nil
+}
+
+// parseJSONNumber accepts a number, a quoted number, an empty string, null or nothing at all
+func parseJSONNumber(data []byte) (float64, error) {
+	if len(data) == 0 || bytes.Equal(data, jsonNull) {
+		return 0, nil
+	}
+
+	str := string(data)
+	if data[0] == '"' {
+		err := json.Unmarshal(data, &str)
+		if err != nil {
+			return 0, err
+		}
+		if len(str) == 0 {
+			return 0, nil
+		}
+	}
+
+	return strconv.ParseFloat(str, 64)
+}
@@ -17,8 +17,9 @@ var (
 const (
 	SymblPlatformHost string = "api.symbl.ai"
 
-	TypeRequestStart string = "start_request"
-	TypeRequestStop  string = "stop_request"
+	TypeRequestStart  string = "start_request"
+	TypeRequestStop   string = "stop_request"
+	TypeRequestModify string = "modify_request"
 )
 
 // Message Types
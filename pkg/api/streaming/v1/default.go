@@ -136,6 +136,23 @@ func (dmr *DefaultMessageRouter) EntityResponseMessage(tr *interfaces.EntityResp
 	return nil
 }
 
+func (dmr *DefaultMessageRouter) SessionModified(sm *interfaces.SessionModifiedMessage) error {
+	data, err := json.Marshal(sm)
+	if err != nil {
+		klog.V(1).Infof("SessionModified json.Marshal failed. Err: %v\n", err)
+		return err
+	}
+
+	prettyJson, err := prettyjson.Format(data)
+	if err != nil {
+		klog.V(1).Infof("prettyjson.Marshal failed. Err: %v\n", err)
+		return err
+	}
+
+	klog.Infof("\n\nSessionModified Object DUMP:\n%s\n\n", prettyJson)
+	return nil
+}
+
 func (dmr *DefaultMessageRouter) TeardownConversation(tm *interfaces.TeardownMessage) error {
 	data, err := json.Marshal(tm)
 	if err != nil {
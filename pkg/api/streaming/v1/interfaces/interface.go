@@ -11,6 +11,7 @@ type InsightCallback interface {
 	TopicResponseMessage(tr *TopicResponse) error
 	TrackerResponseMessage(tr *TrackerResponse) error
 	EntityResponseMessage(tr *EntityResponse) error
+	SessionModified(sm *SessionModifiedMessage) error
 	TeardownConversation(tm *TeardownMessage) error
 	UserDefinedMessage(data []byte) error
 	UnhandledMessage(byMsg []byte) error
@@ -3,6 +3,10 @@
 
 package interfaces
 
+import (
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
 /*
 	Shared definitions
 */
@@ -19,11 +23,11 @@ type From struct {
 type Assignee From
 
 type MessageRef struct {
-	ID        string `json:"id,omitempty"`
-	StartTime string `json:"startTime,omitempty"`
-	EndTime   string `json:"endTime,omitempty"`
-	Text      string `json:"text,omitempty"`
-	Offset    int    `json:"offset,omitempty"`
+	ID        string           `json:"id,omitempty"`
+	StartTime common.Timestamp `json:"startTime,omitempty"`
+	EndTime   common.Timestamp `json:"endTime,omitempty"`
+	Text      string           `json:"text,omitempty"`
+	Offset    int              `json:"offset,omitempty"`
 }
 
 type InsightRef struct {
@@ -39,15 +43,9 @@ type MessageRecognition struct {
 		Raw struct {
 			Alternatives []struct {
 				Words []struct {
-					Word      string `json:"word,omitempty"`
-					StartTime struct {
-						Seconds string `json:"seconds,omitempty"`
-						Nanos   string `json:"nanos,omitempty"`
-					} `json:"startTime,omitempty"`
-					EndTime struct {
-						Seconds string `json:"seconds,omitempty"`
-						Nanos   string `json:"nanos,omitempty"`
-					} `json:"endTime,omitempty"`
+					Word      string               `json:"word,omitempty"`
+					StartTime common.ProtoDuration `json:"startTime,omitempty"`
+					EndTime   common.ProtoDuration `json:"endTime,omitempty"`
 				} `json:"words,omitempty"`
 				Transcript string  `json:"transcript,omitempty"`
 				Confidence float64 `json:"confidence,omitempty"`
@@ -83,10 +81,10 @@ type Message struct {
 	} `json:"metadata,omitempty"`
 	Dismissed bool `json:"dismissed,omitempty"`
 	Duration  struct {
-		StartTime  string  `json:"startTime,omitempty"`
-		EndTime    string  `json:"endTime,omitempty"`
-		TimeOffset float64 `json:"timeOffset,omitempty"`
-		Duration   float64 `json:"duration,omitempty"`
+		StartTime  common.Timestamp `json:"startTime,omitempty"`
+		EndTime    common.Timestamp `json:"endTime,omitempty"`
+		TimeOffset common.Seconds   `json:"timeOffset,omitempty"`
+		Duration   common.Seconds   `json:"duration,omitempty"`
 	} `json:"duration,omitempty"`
 	Entities []Entity `json:"entities,omitempty"`
 }
@@ -209,6 +207,25 @@ type EntityResponse struct {
 	SequenceNumber int      `json:"sequenceNumber,omitempty"`
 }
 
+type SessionModifiedMessage struct {
+	Type    string `json:"type"`
+	Message struct {
+		Type string `json:"type"`
+		Data struct {
+			InsightTypes     []string `json:"insightTypes,omitempty"`
+			CustomVocabulary []string `json:"customVocabulary,omitempty"`
+			Trackers         []struct {
+				Name       string   `json:"name,omitempty"`
+				Vocabulary []string `json:"vocabulary,omitempty"`
+			} `json:"trackers,omitempty"`
+			SpeechRecognition struct {
+				Encoding        string `json:"encoding,omitempty"`
+				SampleRateHertz int    `json:"sampleRateHertz,omitempty"`
+			} `json:"speechRecognition,omitempty"`
+		} `json:"data"`
+	} `json:"message"`
+}
+
 type TeardownMessage struct {
 	Type    string `json:"type"`
 	Message struct {
@@ -95,7 +95,7 @@ func (smr *SymblMessageRouter) handlePlatformMessage(byMsg []byte) error {
 	case MessageTypeInitRecognition:
 		klog.V(3).Infof("Symbl Platform Initialized Recognition\n")
 	case MessageTypeSessionModified:
-		klog.V(3).Infof("Symbl Platform Session Modified\n")
+		return smr.SessionModified(byMsg)
 	case MessageTypeTeardownConversation:
 		return smr.TeardownConversation(byMsg)
 	case MessageTypeTeardownRecognition:
@@ -335,6 +335,35 @@ func (smr *SymblMessageRouter) EntityResponseMessage(byMsg []byte) error {
 	return ErrUserCallbackNotDefined
 }
 
+func (smr *SymblMessageRouter) SessionModified(byMsg []byte) error {
+	klog.V(6).Info("SessionModified ENTER\n")
+
+	var sm interfaces.SessionModifiedMessage
+	err := json.Unmarshal(byMsg, &sm)
+	if err != nil {
+		klog.V(1).Infof("SessionModified json.Unmarshal failed. Err: %v\n", err)
+		klog.V(6).Infof("SessionModified LEAVE\n")
+		return err
+	}
+
+	klog.V(3).Infof("Symbl Platform Session Modified\n")
+
+	if smr.callback != nil {
+		err := smr.callback.SessionModified(&sm)
+		if err != nil {
+			klog.V(1).Infof("callback.SessionModified failed. Err: %v\n", err)
+		} else {
+			klog.V(3).Infof("callback.SessionModified succeeded\n")
+		}
+		klog.V(6).Infof("SessionModified LEAVE\n")
+		return err
+	}
+
+	klog.V(1).Infof("User callback is undefined\n")
+	klog.V(6).Infof("SessionModified LEAVE\n")
+	return ErrUserCallbackNotDefined
+}
+
 func (smr *SymblMessageRouter) TeardownConversation(byMsg []byte) error {
 	klog.V(6).Info("TeardownConversation ENTER\n")
 
@@ -0,0 +1,31 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package microphone
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultFramesPerBuffer is the number of frames read per buffer when not specified
+	DefaultFramesPerBuffer int = 1024
+
+	// DefaultSilenceThreshold in dBFS below which a buffer is considered silent
+	DefaultSilenceThreshold float64 = -50.0
+
+	// DefaultSilenceTimeout is how long the input must be silent before it is reported
+	DefaultSilenceTimeout time.Duration = 5 * time.Second
+
+	// DefaultClippingThreshold as a fraction of full scale at which a sample is considered clipped
+	DefaultClippingThreshold float64 = 0.99
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrDeviceNotFound the requested audio input device was not found
+	ErrDeviceNotFound = errors.New("the requested audio input device was not found")
+)
@@ -0,0 +1,137 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package microphone
+
+import (
+	"strings"
+
+	"github.com/gordonklaus/portaudio"
+	klog "k8s.io/klog/v2"
+)
+
+// ListDevices returns all audio devices on the system which are capable of capturing audio
+func ListDevices() ([]DeviceInfo, error) {
+	err := portaudio.Initialize()
+	if err != nil {
+		klog.V(1).Infof("portaudio.Initialize failed. Err: %v\n", err)
+		return nil, err
+	}
+	defer portaudio.Terminate()
+
+	devices, err := portaudio.Devices()
+	if err != nil {
+		klog.V(1).Infof("portaudio.Devices failed. Err: %v\n", err)
+		return nil, err
+	}
+
+	defaultName := ""
+	defaultDevice, err := portaudio.DefaultInputDevice()
+	if err == nil && defaultDevice != nil {
+		defaultName = defaultDevice.Name
+	}
+
+	result := make([]DeviceInfo, 0)
+	for index, device := range devices {
+		if device.MaxInputChannels == 0 {
+			continue
+		}
+
+		hostAPI := ""
+		if device.HostApi != nil {
+			hostAPI = device.HostApi.Name
+		}
+
+		result = append(result, DeviceInfo{
+			Index:                   index,
+			Name:                    device.Name,
+			HostAPI:                 hostAPI,
+			MaxInputChannels:        device.MaxInputChannels,
+			DefaultSampleRate:       device.DefaultSampleRate,
+			DefaultLowInputLatency:  device.DefaultLowInputLatency,
+			DefaultHighInputLatency: device.DefaultHighInputLatency,
+			IsDefault:               device.Name == defaultName,
+		})
+	}
+
+	klog.V(4).Infof("Found %d input devices\n", len(result))
+	return result, nil
+}
+
+// FindDeviceByName returns the input device matching name. An exact (case-insensitive) match
+// is preferred, otherwise the first device whose name contains name is returned.
+func FindDeviceByName(name string) (*DeviceInfo, error) {
+	if len(name) == 0 {
+		klog.V(1).Infof("name is empty\n")
+		return nil, ErrInvalidInput
+	}
+
+	devices, err := ListDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		if strings.EqualFold(device.Name, name) {
+			klog.V(3).Infof("Found device %d: %s\n", device.Index, device.Name)
+			return &device, nil
+		}
+	}
+
+	lowerName := strings.ToLower(name)
+	for _, device := range devices {
+		if strings.Contains(strings.ToLower(device.Name), lowerName) {
+			klog.V(3).Infof("Found device %d: %s\n", device.Index, device.Name)
+			return &device, nil
+		}
+	}
+
+	klog.V(1).Infof("Device %s not found\n", name)
+	return nil, ErrDeviceNotFound
+}
+
+// FindDeviceByIndex returns the input device at the PortAudio device index
+func FindDeviceByIndex(index int) (*DeviceInfo, error) {
+	devices, err := ListDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, device := range devices {
+		if device.Index == index {
+			klog.V(3).Infof("Found device %d: %s\n", device.Index, device.Name)
+			return &device, nil
+		}
+	}
+
+	klog.V(1).Infof("Device index %d not found\n", index)
+	return nil, ErrDeviceNotFound
+}
+
+func lookupDevice(device *DeviceInfo) (*portaudio.DeviceInfo, error) {
+	if device == nil {
+		return portaudio.DefaultInputDevice()
+	}
+
+	devices, err := portaudio.Devices()
+	if err != nil {
+		klog.V(1).Infof("portaudio.Devices failed. Err: %v\n", err)
+		return nil, err
+	}
+
+	// the index is only stable until devices are added or removed, so confirm the name
+	if device.Index >= 0 && device.Index < len(devices) {
+		candidate := devices[device.Index]
+		if candidate.MaxInputChannels > 0 && (len(device.Name) == 0 || candidate.Name == device.Name) {
+			return candidate, nil
+		}
+	}
+	for _, candidate := range devices {
+		if candidate.MaxInputChannels > 0 && candidate.Name == device.Name {
+			return candidate, nil
+		}
+	}
+
+	klog.V(1).Infof("Device %d (%s) is not a valid input device\n", device.Index, device.Name)
+	return nil, ErrDeviceNotFound
+}
@@ -3,13 +3,29 @@
 
 package interfaces
 
-import "io"
+import (
+	"context"
+	"io"
+	"time"
+)
 
 type Microphone interface {
 	Start() error
 	Read() ([]int16, error)
-	Stream(w io.Writer) error
+	Stream(ctx context.Context, w io.Writer) error
+	GetOverflowCount() uint64
+	GetSampleRate() float64
+	GetLatency() time.Duration
+	GetLevel() Level
 	Mute()
 	Unmute()
 	Stop() error
 }
+
+// LevelCallback receives metering events from the Microphone. The methods are called from the
+// read loop so implementations should return quickly.
+type LevelCallback interface {
+	InputLevel(level *Level) error
+	ClippingDetected(level *Level) error
+	SilenceDetected(duration time.Duration) error
+}
@@ -0,0 +1,18 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package interfaces
+
+import (
+	"time"
+)
+
+// Level is the input level measured over a single buffer of samples
+type Level struct {
+	RMS            float64       // 0.0 to 1.0 of full scale
+	Peak           float64       // 0.0 to 1.0 of full scale
+	RMSDBFS        float64       // decibels relative to full scale
+	PeakDBFS       float64       // decibels relative to full scale
+	ClippedSamples int           // samples at or above the clipping threshold
+	Duration       time.Duration // length of audio measured
+}
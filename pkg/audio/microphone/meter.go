@@ -0,0 +1,118 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package microphone
+
+import (
+	"math"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/audio/microphone/interfaces"
+)
+
+const (
+	fullScale float64 = 32768.0
+
+	// minDBFS is reported for digital silence instead of -Inf
+	minDBFS float64 = -96.0
+)
+
+// ComputeLevel measures the RMS and peak level of the samples. Samples whose magnitude is at or
+// above clippingThreshold (a fraction of full scale) are counted as clipped.
+func ComputeLevel(samples []int16, clippingThreshold float64) interfaces.Level {
+	var level interfaces.Level
+	if len(samples) == 0 {
+		level.RMSDBFS = minDBFS
+		level.PeakDBFS = minDBFS
+		return level
+	}
+
+	clipLevel := clippingThreshold * fullScale
+
+	var sumSquares float64
+	var peak float64
+	for _, sample := range samples {
+		value := math.Abs(float64(sample))
+		sumSquares += value * value
+		if value > peak {
+			peak = value
+		}
+		if value >= clipLevel {
+			level.ClippedSamples++
+		}
+	}
+
+	level.RMS = math.Sqrt(sumSquares/float64(len(samples))) / fullScale
+	level.Peak = peak / fullScale
+	level.RMSDBFS = toDBFS(level.RMS)
+	level.PeakDBFS = toDBFS(level.Peak)
+
+	return level
+}
+
+// GetLevel returns the level of the most recent buffer read
+func (m *Microphone) GetLevel() interfaces.Level {
+	m.levelMu.Lock()
+	defer m.levelMu.Unlock()
+	return m.level
+}
+
+func (m *Microphone) measure(samples []int16) {
+	level := ComputeLevel(samples, m.meter.ClippingThreshold)
+	if m.rate > 0 && m.channels > 0 {
+		frames := len(samples) / m.channels
+		level.Duration = time.Duration(float64(frames) / m.rate * float64(time.Second))
+	}
+
+	m.levelMu.Lock()
+	m.level = level
+	m.levelMu.Unlock()
+
+	callback := m.meter.Callback
+	if callback == nil {
+		return
+	}
+
+	err := callback.InputLevel(&level)
+	if err != nil {
+		klog.V(1).Infof("callback.InputLevel failed. Err: %v\n", err)
+	}
+
+	if level.ClippedSamples > 0 {
+		klog.V(4).Infof("Clipping detected. Samples: %d\n", level.ClippedSamples)
+		err := callback.ClippingDetected(&level)
+		if err != nil {
+			klog.V(1).Infof("callback.ClippingDetected failed. Err: %v\n", err)
+		}
+	}
+
+	// silence is reported once per silent stretch
+	if level.RMSDBFS >= m.meter.SilenceThreshold {
+		m.silentFor = 0
+		m.silenceWarned = false
+		return
+	}
+
+	m.silentFor += level.Duration
+	if !m.silenceWarned && m.silentFor >= m.meter.SilenceTimeout {
+		m.silenceWarned = true
+		klog.V(3).Infof("No audio detected for %v\n", m.silentFor)
+		err := callback.SilenceDetected(m.silentFor)
+		if err != nil {
+			klog.V(1).Infof("callback.SilenceDetected failed. Err: %v\n", err)
+		}
+	}
+}
+
+func toDBFS(value float64) float64 {
+	if value <= 0 {
+		return minDBFS
+	}
+	dbfs := 20 * math.Log10(value)
+	if dbfs < minDBFS {
+		return minDBFS
+	}
+	return dbfs
+}
@@ -5,33 +5,87 @@ package microphone
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"io"
-	"os"
-	"os/signal"
+	"sync/atomic"
+	"time"
 
 	"github.com/gordonklaus/portaudio"
 	klog "k8s.io/klog/v2"
 )
 
 func Initialize(cfg AudioConfig) (*Microphone, error) {
+	framesPerBuffer := cfg.FramesPerBuffer
+	if framesPerBuffer <= 0 {
+		framesPerBuffer = DefaultFramesPerBuffer
+	}
+	channels := cfg.InputChannels
+	if channels <= 0 {
+		channels = 1
+	}
+
+	meter := cfg.Meter
+	if meter.SilenceThreshold == 0 {
+		meter.SilenceThreshold = DefaultSilenceThreshold
+	}
+	if meter.SilenceTimeout <= 0 {
+		meter.SilenceTimeout = DefaultSilenceTimeout
+	}
+	if meter.ClippingThreshold <= 0 || meter.ClippingThreshold > 1 {
+		meter.ClippingThreshold = DefaultClippingThreshold
+	}
+
 	m := &Microphone{
-		sig:    make(chan os.Signal, 1),
-		intBuf: make([]int16, 1024),
-		muted:  false,
+		intBuf:   make([]int16, framesPerBuffer*channels),
+		channels: channels,
+		rate:     float64(cfg.SamplingRate),
+		meter:    meter,
+		muted:    false,
+	}
+
+	err := portaudio.Initialize()
+	if err != nil {
+		klog.V(1).Infof("portaudio.Initialize failed. Err: %v\n", err)
+		return nil, err
+	}
+
+	// no device or latency requested, use the system defaults
+	if cfg.Device == nil && cfg.Latency == 0 {
+		stream, err := portaudio.OpenDefaultStream(channels, 0, float64(cfg.SamplingRate), framesPerBuffer, m.intBuf)
+		if err != nil {
+			klog.V(1).Infof("OpenDefaultStream failed. Err: %v\n", err)
+			return nil, err
+		}
+
+		m.stream = stream
+		klog.V(3).Infof("OpenDefaultStream succeded\n")
+		return m, nil
 	}
-	signal.Notify(m.sig, os.Interrupt, os.Kill)
 
-	portaudio.Initialize()
+	device, err := lookupDevice(cfg.Device)
+	if err != nil {
+		klog.V(1).Infof("lookupDevice failed. Err: %v\n", err)
+		return nil, err
+	}
+	klog.V(4).Infof("Using device: %s\n", device.Name)
+
+	params := portaudio.HighLatencyParameters(device, nil)
+	params.Input.Channels = channels
+	params.SampleRate = float64(cfg.SamplingRate)
+	params.FramesPerBuffer = framesPerBuffer
+	if cfg.Latency > 0 {
+		params.Input.Latency = cfg.Latency
+	}
 
-	stream, err := portaudio.OpenDefaultStream(cfg.InputChannels, 0, float64(cfg.SamplingRate), len(m.intBuf), m.intBuf)
+	stream, err := portaudio.OpenStream(params, m.intBuf)
 	if err != nil {
-		klog.V(1).Infof("OpenDefaultStream failed. Err: %v\n", err)
+		klog.V(1).Infof("OpenStream failed. Err: %v\n", err)
 		return nil, err
 	}
 
 	m.stream = stream
-	klog.V(3).Infof("OpenDefaultStream succeded\n")
+	klog.V(3).Infof("OpenStream succeded\n")
 	return m, nil
 }
 
@@ -47,21 +101,34 @@ func (m *Microphone) Start() error {
 }
 
 func (m *Microphone) Read() ([]int16, error) {
-	err := m.stream.Read()
+	err := m.readStream()
 	if err != nil {
 		klog.V(1).Infof("stream.Read failed. Err: %v\n", err)
 		return nil, err
 	}
 
-	buf := make([]int16, 1024)
+	buf := make([]int16, len(m.intBuf))
 	byteCopied := copy(buf, m.intBuf)
 	klog.V(5).Infof("stream.Read bytes copied: %d\n", byteCopied)
 	return buf, nil
 }
 
-func (m *Microphone) Stream(w io.Writer) error {
+// Stream reads from the microphone and writes the audio to w until ctx is cancelled. Signal
+// handling is left to the caller, typically by using signal.NotifyContext to create ctx.
+func (m *Microphone) Stream(ctx context.Context, w io.Writer) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	for {
-		err := m.stream.Read()
+		select {
+		case <-ctx.Done():
+			klog.V(3).Infof("Stream cancelled\n")
+			return nil
+		default:
+		}
+
+		err := m.readStream()
 		if err != nil {
 			klog.V(1).Infof("stream.Read failed. Err: %v\n", err)
 			return err
@@ -73,15 +140,30 @@ func (m *Microphone) Stream(w io.Writer) error {
 			return err
 		}
 		klog.V(5).Infof("io.Writer succeeded. Bytes written: %d\n", byteCount)
+	}
+}
 
-		select {
-		case <-m.sig:
-			return nil
-		default:
-		}
+// GetOverflowCount returns the number of reads where the input buffer overflowed and audio was dropped
+func (m *Microphone) GetOverflowCount() uint64 {
+	return atomic.LoadUint64(&m.overflows)
+}
+
+// GetSampleRate returns the sample rate negotiated with the device
+func (m *Microphone) GetSampleRate() float64 {
+	info := m.stream.Info()
+	if info == nil {
+		return 0
 	}
+	return info.SampleRate
+}
 
-	return nil
+// GetLatency returns the input latency negotiated with the device
+func (m *Microphone) GetLatency() time.Duration {
+	info := m.stream.Info()
+	if info == nil {
+		return 0
+	}
+	return info.InputLatency
 }
 
 func (m *Microphone) Mute() {
@@ -105,6 +187,23 @@ func (m *Microphone) Stop() error {
 	return nil
 }
 
+// readStream reads the next buffer from the device. An input overflow means some audio was
+// dropped before this buffer, but the buffer itself is valid so it is counted and not returned.
+func (m *Microphone) readStream() error {
+	err := m.stream.Read()
+	if err == portaudio.InputOverflowed {
+		count := atomic.AddUint64(&m.overflows, 1)
+		klog.V(3).Infof("stream.Read input overflowed. Total overflows: %d\n", count)
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+
+	m.measure(m.intBuf)
+	return nil
+}
+
 func Teardown() {
 	portaudio.Terminate()
 }
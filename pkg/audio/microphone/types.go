@@ -4,24 +4,66 @@
 package microphone
 
 import (
-	"os"
 	"sync"
+	"time"
 
 	"github.com/gordonklaus/portaudio"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/audio/microphone/interfaces"
 )
 
 // AudioConfig init config for library
 type AudioConfig struct {
 	InputChannels int
 	SamplingRate  float32
+
+	// Device is the input device to capture from. When nil, the system default is used.
+	Device *DeviceInfo
+	// FramesPerBuffer defaults to DefaultFramesPerBuffer when zero
+	FramesPerBuffer int
+	// Latency is the suggested input latency. When zero, the device default is used.
+	Latency time.Duration
+	// Meter enables input level metering when a Callback is provided
+	Meter MeterConfig
+}
+
+// MeterConfig configures input level metering, clipping and silence detection
+type MeterConfig struct {
+	Callback interfaces.LevelCallback
+	// SilenceThreshold in dBFS, defaults to DefaultSilenceThreshold
+	SilenceThreshold float64
+	// SilenceTimeout defaults to DefaultSilenceTimeout
+	SilenceTimeout time.Duration
+	// ClippingThreshold as a fraction of full scale, defaults to DefaultClippingThreshold
+	ClippingThreshold float64
+}
+
+// DeviceInfo describes an audio input device on the system
+type DeviceInfo struct {
+	Index                   int
+	Name                    string
+	HostAPI                 string
+	MaxInputChannels        int
+	DefaultSampleRate       float64
+	DefaultLowInputLatency  time.Duration
+	DefaultHighInputLatency time.Duration
+	IsDefault               bool
 }
 
 // Microphone...
 type Microphone struct {
 	stream *portaudio.Stream
 
-	intBuf []int16
-	sig    chan os.Signal
+	intBuf    []int16
+	overflows uint64
+	channels  int
+	rate      float64
+
+	meter         MeterConfig
+	level         interfaces.Level
+	levelMu       sync.Mutex
+	silentFor     time.Duration
+	silenceWarned bool
 
 	mute  sync.Mutex
 	muted bool
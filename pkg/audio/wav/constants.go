@@ -0,0 +1,28 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package wav
+
+import (
+	"errors"
+)
+
+const (
+	// DefaultBitsPerSample matches the LINEAR16 encoding used for streaming
+	DefaultBitsPerSample int = 16
+
+	// FileExtension for recordings created by NewForConversation
+	FileExtension string = ".wav"
+
+	headerSize    int    = 44
+	formatPCM     uint16 = 1
+	maxRiffLength int64  = 0xFFFFFFFF
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrWriterClosed the recording has already been finalized
+	ErrWriterClosed = errors.New("the recording has already been finalized")
+)
@@ -0,0 +1,35 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package wav
+
+import (
+	"io"
+	"os"
+	"sync"
+)
+
+// Config describes the PCM audio being recorded
+type Config struct {
+	SampleRate    int `validate:"required"`
+	Channels      int `validate:"required"`
+	BitsPerSample int
+}
+
+// Writer records PCM audio to a WAV file
+type Writer struct {
+	path           string
+	conversationId string
+	cfg            Config
+
+	mu       sync.Mutex
+	file     *os.File
+	dataSize int64
+	closed   bool
+}
+
+// TeeWriter forwards audio to another io.Writer and records what was accepted
+type TeeWriter struct {
+	dst      io.Writer
+	recorder *Writer
+}
@@ -0,0 +1,190 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package wav
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+
+	validator "gopkg.in/go-playground/validator.v9"
+	klog "k8s.io/klog/v2"
+)
+
+// New creates a WAV file at path. The RIFF header is finalized when Close is called.
+func New(path string, cfg Config) (*Writer, error) {
+	klog.V(6).Infof("wav.New ENTER\n")
+
+	// validate input
+	v := validator.New()
+	err := v.Struct(cfg)
+	if err != nil {
+		for _, e := range err.(validator.ValidationErrors) {
+			klog.V(1).Infof("wav.New validation failed. Err: %v\n", e)
+		}
+		klog.V(6).Infof("wav.New LEAVE\n")
+		return nil, err
+	}
+	if len(path) == 0 {
+		klog.V(1).Infof("path is empty\n")
+		klog.V(6).Infof("wav.New LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if cfg.BitsPerSample == 0 {
+		cfg.BitsPerSample = DefaultBitsPerSample
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		klog.V(1).Infof("os.Create failed. Err: %v\n", err)
+		klog.V(6).Infof("wav.New LEAVE\n")
+		return nil, err
+	}
+
+	w := &Writer{
+		path: path,
+		cfg:  cfg,
+		file: file,
+	}
+
+	// placeholder header until the sizes are known
+	err = w.writeHeader()
+	if err != nil {
+		klog.V(1).Infof("writeHeader failed. Err: %v\n", err)
+		klog.V(6).Infof("wav.New LEAVE\n")
+		file.Close()
+		return nil, err
+	}
+
+	klog.V(3).Infof("wav.New Succeeded: %s\n", path)
+	klog.V(6).Infof("wav.New LEAVE\n")
+	return w, nil
+}
+
+// NewForConversation creates the recording <dir>/<conversationId>.wav
+func NewForConversation(dir, conversationId string, cfg Config) (*Writer, error) {
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return nil, ErrInvalidInput
+	}
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		klog.V(1).Infof("os.MkdirAll failed. Err: %v\n", err)
+		return nil, err
+	}
+
+	w, err := New(filepath.Join(dir, conversationId+FileExtension), cfg)
+	if err != nil {
+		return nil, err
+	}
+	w.conversationId = conversationId
+
+	return w, nil
+}
+
+// GetPath returns the location of the recording
+func (w *Writer) GetPath() string {
+	return w.path
+}
+
+// GetConversationId returns the conversation associated with the recording, if any
+func (w *Writer) GetConversationId() string {
+	return w.conversationId
+}
+
+// Write appends raw PCM samples to the recording
+func (w *Writer) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return 0, ErrWriterClosed
+	}
+
+	n, err := w.file.Write(p)
+	w.dataSize += int64(n)
+	if err != nil {
+		klog.V(1).Infof("file.Write failed. Err: %v\n", err)
+	}
+	return n, err
+}
+
+// Close finalizes the RIFF header and closes the file
+func (w *Writer) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	_, err := w.file.Seek(0, io.SeekStart)
+	if err != nil {
+		klog.V(1).Infof("file.Seek failed. Err: %v\n", err)
+		w.file.Close()
+		return err
+	}
+
+	err = w.writeHeader()
+	if err != nil {
+		klog.V(1).Infof("writeHeader failed. Err: %v\n", err)
+		w.file.Close()
+		return err
+	}
+
+	klog.V(3).Infof("Recording %s finalized. Bytes: %d\n", w.path, w.dataSize)
+	return w.file.Close()
+}
+
+func (w *Writer) writeHeader() error {
+	dataSize := w.dataSize
+	if dataSize > maxRiffLength-int64(headerSize) {
+		klog.V(1).Infof("Recording exceeds the maximum WAV size, header will be truncated\n")
+		dataSize = maxRiffLength - int64(headerSize)
+	}
+
+	blockAlign := w.cfg.Channels * w.cfg.BitsPerSample / 8
+	byteRate := w.cfg.SampleRate * blockAlign
+
+	header := make([]byte, headerSize)
+	copy(header[0:4], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:8], uint32(dataSize+int64(headerSize)-8))
+	copy(header[8:12], "WAVE")
+	copy(header[12:16], "fmt ")
+	binary.LittleEndian.PutUint32(header[16:20], 16)
+	binary.LittleEndian.PutUint16(header[20:22], formatPCM)
+	binary.LittleEndian.PutUint16(header[22:24], uint16(w.cfg.Channels))
+	binary.LittleEndian.PutUint32(header[24:28], uint32(w.cfg.SampleRate))
+	binary.LittleEndian.PutUint32(header[28:32], uint32(byteRate))
+	binary.LittleEndian.PutUint16(header[32:34], uint16(blockAlign))
+	binary.LittleEndian.PutUint16(header[34:36], uint16(w.cfg.BitsPerSample))
+	copy(header[36:40], "data")
+	binary.LittleEndian.PutUint32(header[40:44], uint32(dataSize))
+
+	_, err := w.file.Write(header)
+	return err
+}
+
+// NewTeeWriter returns an io.Writer which writes to dst and records only the bytes dst accepted,
+// so the recording contains exactly what was sent. It can be passed to microphone.Stream.
+func NewTeeWriter(dst io.Writer, recorder *Writer) *TeeWriter {
+	return &TeeWriter{
+		dst:      dst,
+		recorder: recorder,
+	}
+}
+
+func (t *TeeWriter) Write(p []byte) (int, error) {
+	n, err := t.dst.Write(p)
+	if n > 0 && t.recorder != nil {
+		_, recErr := t.recorder.Write(p[:n])
+		if recErr != nil {
+			klog.V(1).Infof("recorder.Write failed. Err: %v\n", recErr)
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,522 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	klog "k8s.io/klog/v2"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// New wraps client so that intelligence results are cached in store
+func New(client *async.Client, store Store) *Client {
+	return &Client{
+		client:  client,
+		store:   store,
+		pending: make(map[string]string),
+	}
+}
+
+// Async returns the wrapped client for calls which are not cached. Changes made through it
+// are not seen by the cache; call Invalidate or Track afterwards.
+func (c *Client) Async() *async.Client {
+	return c.client
+}
+
+// Invalidate removes the cached results of a conversation
+func (c *Client) Invalidate(conversationId string) error {
+	return c.store.Invalidate(conversationId)
+}
+
+// Track marks the conversation of a job submitted outside of this client as not cacheable
+// until the job completes. Jobs submitted through this client are tracked automatically.
+func (c *Client) Track(jobConvo *async.JobConversation) {
+	if jobConvo == nil || len(jobConvo.JobID) == 0 || len(jobConvo.ConversationID) == 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pending[jobConvo.ConversationID] = jobConvo.JobID
+}
+
+func (c *Client) GetMessages(ctx context.Context, conversationId string) (*interfaces.MessageResult, error) {
+	var result interfaces.MessageResult
+	err := c.cached(ctx, conversationId, async.InsightMessages, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetTopics(ctx context.Context, conversationId string) (*interfaces.TopicResult, error) {
+	var result interfaces.TopicResult
+	err := c.cached(ctx, conversationId, async.InsightTopics, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetQuestions(ctx context.Context, conversationId string) (*interfaces.QuestionResult, error) {
+	var result interfaces.QuestionResult
+	err := c.cached(ctx, conversationId, async.InsightQuestions, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetActionItems(ctx context.Context, conversationId string) (*interfaces.ActionItemResult, error) {
+	var result interfaces.ActionItemResult
+	err := c.cached(ctx, conversationId, async.InsightActionItems, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetFollowUps(ctx context.Context, conversationId string) (*interfaces.FollowUpResult, error) {
+	var result interfaces.FollowUpResult
+	err := c.cached(ctx, conversationId, async.InsightFollowUps, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetEntities(ctx context.Context, conversationId string) (*interfaces.EntityResult, error) {
+	var result interfaces.EntityResult
+	err := c.cached(ctx, conversationId, async.InsightEntities, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetSummary(ctx context.Context, conversationId string) (*interfaces.SummaryResult, error) {
+	var result interfaces.SummaryResult
+	err := c.cached(ctx, conversationId, async.InsightSummary, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetAnalytics(ctx context.Context, conversationId string) (*interfaces.AnalyticsResult, error) {
+	var result interfaces.AnalyticsResult
+	err := c.cached(ctx, conversationId, async.InsightAnalytics, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetTracker(ctx context.Context, conversationId string) (*interfaces.TrackerResult, error) {
+	var result interfaces.TrackerResult
+	err := c.cached(ctx, conversationId, async.InsightTrackers, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetMembers(ctx context.Context, conversationId string) (*interfaces.MembersResult, error) {
+	var result interfaces.MembersResult
+	err := c.cached(ctx, conversationId, async.InsightMembers, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// FetchInsights retrieves the requested insights through the cache, concurrently like
+// async.Client.FetchInsights. When insights is empty, async.DefaultInsights are fetched. When
+// only some insights could be fetched, the partial result is returned along with
+// async.ErrPartialInsights.
+func (c *Client) FetchInsights(ctx context.Context, conversationId string, insights []string) (*async.ConversationInsights, error) {
+	klog.V(6).Infof("cache.FetchInsights ENTER\n")
+
+	// checks
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("cache.FetchInsights LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if len(insights) == 0 {
+		insights = async.DefaultInsights
+	}
+	for _, insight := range insights {
+		if newInsightResult(insight) == nil {
+			klog.V(1).Infof("Invalid insight type: %s\n", insight)
+			klog.V(6).Infof("cache.FetchInsights LEAVE\n")
+			return nil, async.ErrInvalidInsightType
+		}
+	}
+
+	result := &async.ConversationInsights{
+		ConversationID: conversationId,
+		Errors:         make(map[string]error),
+	}
+
+	// the job status is checked once rather than for each insight
+	cacheable := c.settled(ctx, conversationId)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, insight := range insights {
+		wg.Add(1)
+		go func(insight string) {
+			defer wg.Done()
+
+			value := newInsightResult(insight)
+			err := c.load(ctx, conversationId, insight, cacheable, value)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				klog.V(1).Infof("Fetching %s failed. Err: %v\n", insight, err)
+				result.Errors[insight] = err
+				return
+			}
+			setInsightResult(result, insight, value)
+		}(insight)
+	}
+	wg.Wait()
+
+	if len(result.Errors) > 0 {
+		klog.V(1).Infof("%d of %d insights failed\n", len(result.Errors), len(insights))
+		klog.V(6).Infof("cache.FetchInsights LEAVE\n")
+		return result, async.ErrPartialInsights
+	}
+
+	klog.V(6).Infof("cache.FetchInsights LEAVE\n")
+	return result, nil
+}
+
+// PostText creates a conversation which is not cached until its job completes
+func (c *Client) PostText(ctx context.Context, messages []string) (*async.JobConversation, error) {
+	return c.track(c.client.PostText(ctx, messages))
+}
+
+// PostTextWithOptions creates a conversation which is not cached until its job completes
+func (c *Client) PostTextWithOptions(ctx context.Context, textRequest interfaces.AsyncTextRequest) (*async.JobConversation, error) {
+	return c.track(c.client.PostTextWithOptions(ctx, textRequest))
+}
+
+// PostTextChunked creates a conversation which is not cached until its final job completes
+func (c *Client) PostTextChunked(ctx context.Context, textRequest interfaces.AsyncTextRequest, options async.TextChunkOptions) (*async.JobConversation, error) {
+	return c.track(c.client.PostTextChunked(ctx, textRequest, options))
+}
+
+// PostFileWithOptions creates a conversation which is not cached until its job completes
+func (c *Client) PostFileWithOptions(ctx context.Context, filePath string, options interfaces.AsyncOptions) (*async.JobConversation, error) {
+	return c.track(c.client.PostFileWithOptions(ctx, filePath, options))
+}
+
+// PostURLWithOptions creates a conversation which is not cached until its job completes
+func (c *Client) PostURLWithOptions(ctx context.Context, options interfaces.AsyncOptions) (*async.JobConversation, error) {
+	return c.track(c.client.PostURLWithOptions(ctx, options))
+}
+
+// PostAppendText appends to the conversation. Its cached results are dropped and it is not
+// cached again until the append job completes.
+func (c *Client) PostAppendText(ctx context.Context, conversationId string, messages []string) (*async.JobConversation, error) {
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return nil, ErrInvalidInput
+	}
+	c.invalidate(conversationId)
+	return c.track(c.client.PostAppendText(ctx, conversationId, messages))
+}
+
+// PostAppendTextWithOptions appends to the conversation. Its cached results are dropped and it
+// is not cached again until the append job completes.
+func (c *Client) PostAppendTextWithOptions(ctx context.Context, conversationId string, textRequest interfaces.AsyncTextRequest) (*async.JobConversation, error) {
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return nil, ErrInvalidInput
+	}
+	c.invalidate(conversationId)
+	return c.track(c.client.PostAppendTextWithOptions(ctx, conversationId, textRequest))
+}
+
+// PostAppendTextChunked appends to the conversation. Its cached results are dropped and it is
+// not cached again until the final append job completes.
+func (c *Client) PostAppendTextChunked(ctx context.Context, conversationId string, textRequest interfaces.AsyncTextRequest, options async.TextChunkOptions) (*async.JobConversation, error) {
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return nil, ErrInvalidInput
+	}
+	c.invalidate(conversationId)
+	return c.track(c.client.PostAppendTextChunked(ctx, conversationId, textRequest, options))
+}
+
+// UpdateMember updates the member and invalidates the cached results of the conversation
+func (c *Client) UpdateMember(ctx context.Context, conversationId string, member interfaces.Member) error {
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return ErrInvalidInput
+	}
+	defer c.invalidate(conversationId)
+	return c.client.UpdateMember(ctx, conversationId, member)
+}
+
+// UpdateSpeakers updates the speakers and invalidates the cached results of the conversation
+func (c *Client) UpdateSpeakers(ctx context.Context, conversationId string, speakers interfaces.UpdateSpeakerRequest) error {
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return ErrInvalidInput
+	}
+	defer c.invalidate(conversationId)
+	return c.client.UpdateSpeakers(ctx, conversationId, speakers)
+}
+
+// UpdateConversation updates the conversation and invalidates its cached results
+func (c *Client) UpdateConversation(ctx context.Context, conversationId string, request interfaces.UpdateConversationRequest) (*interfaces.Conversation, error) {
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return nil, ErrInvalidInput
+	}
+	defer c.invalidate(conversationId)
+	return c.client.UpdateConversation(ctx, conversationId, request)
+}
+
+// DeleteConversation deletes the conversation and its cached results
+func (c *Client) DeleteConversation(ctx context.Context, conversationId string) error {
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return ErrInvalidInput
+	}
+	defer c.invalidate(conversationId)
+	return c.client.DeleteConversation(ctx, conversationId)
+}
+
+// cached decodes the stored response for endpoint into result or fetches it from the platform
+func (c *Client) cached(ctx context.Context, conversationId, endpoint string, result interface{}) error {
+	// checks
+	if len(conversationId) == 0 {
+		klog.V(1).Infof("conversationId is empty\n")
+		return ErrInvalidInput
+	}
+
+	return c.load(ctx, conversationId, endpoint, c.settled(ctx, conversationId), result)
+}
+
+// load decodes the stored response into result or fetches it and stores the response. Empty
+// responses and responses which are not cacheable, because a job is still in progress, are
+// returned without being stored. Store failures are logged and the platform is used instead.
+func (c *Client) load(ctx context.Context, conversationId, endpoint string, cacheable bool, result interface{}) error {
+	klog.V(6).Infof("cache.load ENTER\n")
+
+	if cacheable {
+		data, ok, err := c.store.Get(conversationId, endpoint)
+		if err != nil {
+			klog.V(1).Infof("store.Get failed. Err: %v\n", err)
+		}
+		if ok {
+			err = json.Unmarshal(data, result)
+			if err == nil {
+				klog.V(4).Infof("Cache hit %s/%s\n", conversationId, endpoint)
+				klog.V(6).Infof("cache.load LEAVE\n")
+				return nil
+			}
+			klog.V(1).Infof("Discarding corrupt cache entry %s/%s. Err: %v\n", conversationId, endpoint, err)
+		}
+		klog.V(4).Infof("Cache miss %s/%s\n", conversationId, endpoint)
+	}
+
+	response, err := c.fetch(ctx, conversationId, endpoint)
+	if err != nil {
+		klog.V(1).Infof("%s failed. Err: %v\n", endpoint, err)
+		klog.V(6).Infof("cache.load LEAVE\n")
+		return err
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		klog.V(1).Infof("json.Marshal failed. Err: %v\n", err)
+		klog.V(6).Infof("cache.load LEAVE\n")
+		return err
+	}
+
+	switch {
+	case !cacheable:
+		klog.V(4).Infof("Not caching %s/%s, job in progress\n", conversationId, endpoint)
+	case isEmpty(data):
+		klog.V(4).Infof("Not caching empty %s/%s\n", conversationId, endpoint)
+	default:
+		err = c.store.Set(conversationId, endpoint, data)
+		if err != nil {
+			klog.V(1).Infof("store.Set failed. Err: %v\n", err)
+		}
+	}
+
+	klog.V(6).Infof("cache.load LEAVE\n")
+	return json.Unmarshal(data, result)
+}
+
+// fetch calls the platform for endpoint
+func (c *Client) fetch(ctx context.Context, conversationId, endpoint string) (interface{}, error) {
+	switch endpoint {
+	case async.InsightMessages:
+		return c.client.GetMessages(ctx, conversationId)
+	case async.InsightTopics:
+		return c.client.GetTopics(ctx, conversationId)
+	case async.InsightQuestions:
+		return c.client.GetQuestions(ctx, conversationId)
+	case async.InsightActionItems:
+		return c.client.GetActionItems(ctx, conversationId)
+	case async.InsightFollowUps:
+		return c.client.GetFollowUps(ctx, conversationId)
+	case async.InsightEntities:
+		return c.client.GetEntities(ctx, conversationId)
+	case async.InsightSummary:
+		return c.client.GetSummary(ctx, conversationId)
+	case async.InsightAnalytics:
+		return c.client.GetAnalytics(ctx, conversationId)
+	case async.InsightTrackers:
+		return c.client.GetTracker(ctx, conversationId)
+	case async.InsightMembers:
+		return c.client.GetMembers(ctx, conversationId)
+	}
+	return nil, async.ErrInvalidInsightType
+}
+
+// settled reports whether the conversation has no tracked job in progress. When a tracked job
+// has finished, the results cached before it are dropped.
+func (c *Client) settled(ctx context.Context, conversationId string) bool {
+	c.mu.Lock()
+	jobId, pending := c.pending[conversationId]
+	c.mu.Unlock()
+	if !pending {
+		return true
+	}
+
+	jobStatus, err := c.client.GetJobStatus(ctx, jobId)
+	if err != nil {
+		klog.V(1).Infof("GetJobStatus failed. Err: %v\n", err)
+		return false
+	}
+	if jobStatus.Status != async.JobStatusComplete && jobStatus.Status != async.JobStatusFailed {
+		klog.V(4).Infof("Job %s for %s is %s\n", jobId, conversationId, jobStatus.Status)
+		return false
+	}
+
+	c.mu.Lock()
+	if c.pending[conversationId] == jobId {
+		delete(c.pending, conversationId)
+	}
+	c.mu.Unlock()
+
+	klog.V(4).Infof("Job %s for %s is %s\n", jobId, conversationId, jobStatus.Status)
+	c.invalidate(conversationId)
+	return true
+}
+
+// track records the job of a successful submission
+func (c *Client) track(jobConvo *async.JobConversation, err error) (*async.JobConversation, error) {
+	c.Track(jobConvo)
+	return jobConvo, err
+}
+
+func (c *Client) invalidate(conversationId string) {
+	err := c.store.Invalidate(conversationId)
+	if err != nil {
+		klog.V(1).Infof("store.Invalidate failed. Err: %v\n", err)
+	}
+}
+
+// isEmpty reports whether an encoded response has no content, such as {"messages":[]}
+func isEmpty(data []byte) bool {
+	var value interface{}
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return true
+	}
+	return isEmptyValue(value)
+}
+
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return len(v) == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		for _, field := range v {
+			if !isEmptyValue(field) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// newInsightResult returns an empty result for insight or nil when it isn't supported
+func newInsightResult(insight string) interface{} {
+	switch insight {
+	case async.InsightMessages:
+		return &interfaces.MessageResult{}
+	case async.InsightTopics:
+		return &interfaces.TopicResult{}
+	case async.InsightQuestions:
+		return &interfaces.QuestionResult{}
+	case async.InsightActionItems:
+		return &interfaces.ActionItemResult{}
+	case async.InsightFollowUps:
+		return &interfaces.FollowUpResult{}
+	case async.InsightEntities:
+		return &interfaces.EntityResult{}
+	case async.InsightSummary:
+		return &interfaces.SummaryResult{}
+	case async.InsightAnalytics:
+		return &interfaces.AnalyticsResult{}
+	case async.InsightTrackers:
+		return &interfaces.TrackerResult{}
+	case async.InsightMembers:
+		return &interfaces.MembersResult{}
+	}
+	return nil
+}
+
+// setInsightResult stores a value created by newInsightResult in result
+func setInsightResult(result *async.ConversationInsights, insight string, value interface{}) {
+	switch insight {
+	case async.InsightMessages:
+		result.Messages = value.(*interfaces.MessageResult)
+	case async.InsightTopics:
+		result.Topics = value.(*interfaces.TopicResult)
+	case async.InsightQuestions:
+		result.Questions = value.(*interfaces.QuestionResult)
+	case async.InsightActionItems:
+		result.ActionItems = value.(*interfaces.ActionItemResult)
+	case async.InsightFollowUps:
+		result.FollowUps = value.(*interfaces.FollowUpResult)
+	case async.InsightEntities:
+		result.Entities = value.(*interfaces.EntityResult)
+	case async.InsightSummary:
+		result.Summary = value.(*interfaces.SummaryResult)
+	case async.InsightAnalytics:
+		result.Analytics = value.(*interfaces.AnalyticsResult)
+	case async.InsightTrackers:
+		result.Trackers = value.(*interfaces.TrackerResult)
+	case async.InsightMembers:
+		result.Members = value.(*interfaces.MembersResult)
+	}
+}
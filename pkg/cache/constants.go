@@ -0,0 +1,21 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"errors"
+)
+
+const (
+	// DefaultCapacity of the MemoryStore in entries
+	DefaultCapacity int = 256
+
+	// entryExtension is used for the files in a DiskStore
+	entryExtension string = ".json"
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+)
@@ -0,0 +1,168 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"container/list"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	atomicfile "github.com/dvonthenen/symbl-go-sdk/pkg/internal/atomicfile"
+)
+
+// NewMemoryStore creates a MemoryStore holding at most capacity entries
+func NewMemoryStore(capacity int) *MemoryStore {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+
+	return &MemoryStore{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (s *MemoryStore) Get(conversationId, endpoint string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[memoryKey(conversationId, endpoint)]
+	if !ok {
+		return nil, false, nil
+	}
+	s.order.MoveToFront(element)
+	return element.Value.(*memoryEntry).data, true, nil
+}
+
+func (s *MemoryStore) Set(conversationId, endpoint string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := memoryKey(conversationId, endpoint)
+	if element, ok := s.entries[key]; ok {
+		element.Value.(*memoryEntry).data = data
+		s.order.MoveToFront(element)
+		return nil
+	}
+
+	s.entries[key] = s.order.PushFront(&memoryEntry{
+		conversationId: conversationId,
+		endpoint:       endpoint,
+		data:           data,
+	})
+
+	for s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		entry := oldest.Value.(*memoryEntry)
+		delete(s.entries, memoryKey(entry.conversationId, entry.endpoint))
+		s.order.Remove(oldest)
+	}
+	return nil
+}
+
+func (s *MemoryStore) Invalidate(conversationId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for element := s.order.Front(); element != nil; {
+		next := element.Next()
+		entry := element.Value.(*memoryEntry)
+		if entry.conversationId == conversationId {
+			delete(s.entries, memoryKey(entry.conversationId, entry.endpoint))
+			s.order.Remove(element)
+		}
+		element = next
+	}
+	return nil
+}
+
+// Len is the number of cached entries
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.order.Len()
+}
+
+func memoryKey(conversationId, endpoint string) string {
+	return conversationId + "/" + endpoint
+}
+
+// NewDiskStore creates a DiskStore in dir, creating the directory if needed
+func NewDiskStore(dir string) (*DiskStore, error) {
+	if len(dir) == 0 {
+		return nil, ErrInvalidInput
+	}
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiskStore{
+		dir: dir,
+	}, nil
+}
+
+func (s *DiskStore) Get(conversationId, endpoint string) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	path, err := s.path(conversationId, endpoint)
+	if err != nil {
+		return nil, false, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return data, true, nil
+}
+
+func (s *DiskStore) Set(conversationId, endpoint string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path, err := s.path(conversationId, endpoint)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.WriteFile(path, data)
+}
+
+func (s *DiskStore) Invalidate(conversationId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !isPathElement(conversationId) {
+		return ErrInvalidInput
+	}
+	return os.RemoveAll(filepath.Join(s.dir, conversationId))
+}
+
+func (s *DiskStore) path(conversationId, endpoint string) (string, error) {
+	if !isPathElement(conversationId) || !isPathElement(endpoint) {
+		return "", ErrInvalidInput
+	}
+	return filepath.Join(s.dir, conversationId, endpoint+entryExtension), nil
+}
+
+// isPathElement reports whether name stays a single entry when joined below the cache
+// directory, so it can never resolve to the directory itself or one of its parents
+func isPathElement(name string) bool {
+	return len(name) > 0 && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"container/list"
+	"sync"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+)
+
+// Store persists cached responses keyed by conversation ID and endpoint
+type Store interface {
+	Get(conversationId, endpoint string) ([]byte, bool, error)
+	Set(conversationId, endpoint string, data []byte) error
+	// Invalidate removes every endpoint cached for the conversation
+	Invalidate(conversationId string) error
+}
+
+// Client wraps async.Client and caches the conversation intelligence results. Calls which
+// change a conversation invalidate its cached results, and a conversation with a submitted job
+// is not cached until the job completes. Other calls are available through Async.
+type Client struct {
+	client *async.Client
+	store  Store
+
+	mu      sync.Mutex
+	pending map[string]string // conversationId -> jobId
+}
+
+// MemoryStore is an in memory least recently used Store
+type MemoryStore struct {
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+type memoryEntry struct {
+	conversationId string
+	endpoint       string
+	data           []byte
+}
+
+// DiskStore keeps each conversation in its own directory so it survives restarts
+type DiskStore struct {
+	dir string
+
+	mu sync.RWMutex
+}
@@ -26,4 +26,7 @@ var (
 
 	// ErrWebSocketInitializationFailed websocket initialization failed
 	ErrWebSocketInitializationFailed = errors.New("websocket initialization failed")
+
+	// ErrRecordingEncodingNotSupported recording is only supported for LINEAR16 audio
+	ErrRecordingEncodingNotSupported = errors.New("recording is only supported for LINEAR16 audio")
 )
@@ -33,3 +33,12 @@ type StreamingConfig struct {
 	Config           Config    `json:"config,omitempty"`
 	Speaker          Speaker   `json:"speaker,omitempty"`
 }
+
+// ModifyConfig contains the settings that can be changed on a running streaming session
+type ModifyConfig struct {
+	Type              string             `json:"type,omitempty"`
+	InsightTypes      []string           `json:"insightTypes,omitempty"`
+	CustomVocabulary  []string           `json:"customVocabulary,omitempty"`
+	Trackers          []Tracker          `json:"trackers,omitempty"`
+	SpeechRecognition *SpeechRecognition `json:"speechRecognition,omitempty"`
+}
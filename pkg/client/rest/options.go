@@ -0,0 +1,91 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+const (
+	// options which only apply to URL submissions
+	optionURL string = "url"
+)
+
+// EncodeOptionsJSON encodes options as the request body used for URL submissions
+func EncodeOptionsJSON(options interfaces.AsyncOptions) ([]byte, error) {
+	return json.Marshal(options)
+}
+
+// EncodeOptionsQuery encodes options as the query string used for file uploads. The values are
+// derived from the JSON encoding so both submission paths always honor identical options. Scalars
+// become a single parameter, lists of strings are repeated and anything else, such as
+// channelMetadata, is passed as a JSON string.
+func EncodeOptionsQuery(options interfaces.AsyncOptions) (url.Values, error) {
+	data, err := EncodeOptionsJSON(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	err = d.Decode(&fields)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for key, field := range fields {
+		if key == optionURL {
+			continue
+		}
+
+		switch v := field.(type) {
+		case string:
+			values.Set(key, v)
+		case json.Number:
+			values.Set(key, v.String())
+		case bool:
+			values.Set(key, fmt.Sprintf("%t", v))
+		case []interface{}:
+			if isStringSlice(v) {
+				for _, item := range v {
+					values.Add(key, item.(string))
+				}
+				continue
+			}
+			err = setJSONValue(values, key, v)
+		default:
+			err = setJSONValue(values, key, v)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return values, nil
+}
+
+func setJSONValue(values url.Values, key string, v interface{}) error {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	values.Set(key, string(encoded))
+	return nil
+}
+
+func isStringSlice(items []interface{}) bool {
+	for _, item := range items {
+		if _, ok := item.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,27 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import (
+	"io"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// progressReader reports the number of bytes read from the underlying reader
+type progressReader struct {
+	reader   io.Reader
+	total    int64
+	read     int64
+	progress interfaces.ProgressCallback
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.reader.Read(b)
+	if n > 0 {
+		p.read += int64(n)
+		p.progress(p.read, p.total)
+	}
+	return n, err
+}
@@ -4,6 +4,7 @@
 package rest
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -311,48 +312,64 @@ func (c *Client) DoFile(ctx context.Context, filePath string, options interfaces
 		return ErrInvalidInput
 	}
 
-	baseName := filepath.Base(strings.TrimSpace(filePath))
 	klog.V(4).Infof("filePath: %s\n", filePath)
-	klog.V(4).Infof("baseName: %s\n", baseName)
 
-	// file
-	pos := strings.LastIndex(filePath, ".")
-	if pos == -1 {
-		err := ErrInvalidURIExtension
-		klog.V(1).Infof("uri is invalid. Err: %v\n", err)
+	// sniff the header
+	mediaType, err := detectFileMediaType(filePath)
+	if err != nil {
+		klog.V(1).Infof("detectFileMediaType failed. Err: %v\n", err)
 		return err
 	}
-
-	extension := filePath[pos+1:]
-	klog.V(3).Infof("extension: %s\n", extension)
+	klog.V(3).Infof("Content-Type: %s\n", mediaType.ContentType)
 
 	// is audio?
-	switch extension {
-	case common.AudioTypeMP3:
+	if mediaType.IsAudio {
 		klog.V(3).Infof("IsAudio = TRUE\n")
-		return c.doAudioFile(ctx, filePath, options, resBody)
-	case common.AudioTypeMpeg:
-		klog.V(3).Infof("IsAudio = TRUE\n")
-		return c.doAudioFile(ctx, filePath, options, resBody)
-	case common.AudioTypeWav:
-		klog.V(3).Infof("IsAudio = TRUE\n")
-		return c.doAudioFile(ctx, filePath, options, resBody)
+		return c.doCommonFile(ctx, version.ProcessAudioURI, filePath, mediaType.ContentType, options, resBody)
 	}
 
-	// assume video
-	klog.V(3).Infof("Defaulting IsVideo = TRUE\n")
-	return c.doVideoFile(ctx, filePath, options, resBody)
+	klog.V(3).Infof("IsVideo = TRUE\n")
+	return c.doCommonFile(ctx, version.ProcessVideoURI, filePath, mediaType.ContentType, options, resBody)
 }
 
-func (c *Client) doAudioFile(ctx context.Context, filePath string, options interfaces.AsyncOptions, resBody interface{}) error {
-	return c.doCommonFile(ctx, version.ProcessAudioURI, filePath, options, resBody)
-}
+// detectFileMediaType uses the file header to determine the media type, falling back to the
+// file extension and then to video when the format isn't recognized
+func detectFileMediaType(filePath string) (common.MediaType, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return common.MediaType{}, err
+	}
+	defer file.Close()
 
-func (c *Client) doVideoFile(ctx context.Context, filePath string, options interfaces.AsyncOptions, resBody interface{}) error {
-	return c.doCommonFile(ctx, version.ProcessVideoURI, filePath, options, resBody)
+	header := make([]byte, sniffLen)
+	byteCount, err := io.ReadFull(file, header)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return common.MediaType{}, err
+	}
+
+	if mediaType, ok := DetectMediaType(header[:byteCount]); ok {
+		klog.V(4).Infof("Detected %s from file header\n", mediaType.ContentType)
+		return mediaType, nil
+	}
+
+	pos := strings.LastIndex(filePath, ".")
+	if pos == -1 {
+		return common.MediaType{}, ErrInvalidURIExtension
+	}
+
+	extension := filePath[pos+1:]
+	klog.V(3).Infof("extension: %s\n", extension)
+
+	if mediaType, ok := common.MediaTypeByExtension(extension); ok {
+		return mediaType, nil
+	}
+
+	// assume video
+	klog.V(3).Infof("Unrecognized media, defaulting to video\n")
+	return common.MediaType{Extension: extension}, nil
 }
 
-func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath string, options interfaces.AsyncOptions, resBody interface{}) error {
+func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath, contentType string, options interfaces.AsyncOptions, resBody interface{}) error {
 	klog.V(6).Infof("rest.doCommonFile ENTER\n")
 
 	// checks
@@ -381,46 +398,101 @@ func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath string, opti
 	}
 	defer file.Close()
 
-	// start: until multipart post is supported, options must be used as a query string
-	params := make([]string, 0)
-	params = append(params, "?")
+	readerOptions := interfaces.ReaderOptions{
+		Name:          baseName,
+		ContentType:   contentType,
+		ContentLength: fileInfo.Size(),
+	}
 
-	if len(options.Name) > 0 {
-		params = append(params, fmt.Sprintf("name=%s", options.Name))
+	klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+	return c.doCommonReader(ctx, apiURI, file, readerOptions, options, resBody)
+}
+
+// DoReader uploads media from r, for example a download stream from object storage. The endpoint
+// is chosen from readerOptions.ContentType and readerOptions.Progress is notified as the body is sent.
+func (c *Client) DoReader(ctx context.Context, r io.Reader, readerOptions interfaces.ReaderOptions, options interfaces.AsyncOptions, resBody interface{}) error {
+	// checks
+	if r == nil {
+		klog.V(1).Infof("Reader is nil\n")
+		return ErrInvalidInput
 	}
-	if options.ConfidenceThreshold > 0 {
-		params = append(params, fmt.Sprintf("confidenceThreshold=%f", options.ConfidenceThreshold))
+	if len(readerOptions.Name) == 0 {
+		readerOptions.Name = options.Name
 	}
-	if options.DetectPhrases {
-		params = append(params, fmt.Sprintf("detectPhrases=%t", options.DetectPhrases))
+	if len(readerOptions.Name) == 0 {
+		klog.V(1).Infof("Name is required when uploading from a reader\n")
+		return ErrInvalidInput
 	}
-	if options.DetectEntities {
-		params = append(params, fmt.Sprintf("detectEntities=%t", options.DetectEntities))
+
+	// sniff the header when the caller doesn't know the content type
+	if len(readerOptions.ContentType) == 0 {
+		bufReader := bufio.NewReaderSize(r, sniffLen)
+		header, err := bufReader.Peek(sniffLen)
+		if err != nil && err != io.EOF {
+			klog.V(1).Infof("Peek failed. Err: %v\n", err)
+			return err
+		}
+		if mediaType, ok := DetectMediaType(header); ok {
+			klog.V(4).Infof("Detected %s from stream header\n", mediaType.ContentType)
+			readerOptions.ContentType = mediaType.ContentType
+		}
+		r = bufReader
 	}
-	if len(options.LanguageCode) > 0 {
-		params = append(params, fmt.Sprintf("languageCode=%s", options.LanguageCode))
+
+	isAudio := strings.HasPrefix(strings.ToLower(readerOptions.ContentType), "audio/")
+	if mediaType, ok := common.MediaTypeByContentType(readerOptions.ContentType); ok {
+		isAudio = mediaType.IsAudio
 	}
-	for _, vocab := range options.CustomVocabulary {
-		params = append(params, fmt.Sprintf("customVocabulary=%s", vocab))
+
+	// is audio?
+	if isAudio {
+		klog.V(3).Infof("IsAudio = TRUE\n")
+		return c.doCommonReader(ctx, version.ProcessAudioURI, r, readerOptions, options, resBody)
 	}
-	if options.ParentRefs {
-		params = append(params, fmt.Sprintf("parentRefs=%t", options.ParentRefs))
+
+	// assume video
+	klog.V(3).Infof("Defaulting IsVideo = TRUE\n")
+	return c.doCommonReader(ctx, version.ProcessVideoURI, r, readerOptions, options, resBody)
+}
+
+func (c *Client) doCommonReader(ctx context.Context, apiURI string, r io.Reader, readerOptions interfaces.ReaderOptions, options interfaces.AsyncOptions, resBody interface{}) error {
+	klog.V(6).Infof("rest.doCommonReader ENTER\n")
+
+	baseName := readerOptions.Name
+	klog.V(4).Infof("baseName: %s\n", baseName)
+
+	// until multipart post is supported, options must be used as a query string
+	if len(options.Name) > 0 {
+		baseName = options.Name
 	}
-	if options.Sentiment {
-		params = append(params, fmt.Sprintf("sentiment=%t", options.Sentiment))
+	options.Name = ""
+
+	params, err := EncodeOptionsQuery(options)
+	if err != nil {
+		klog.V(1).Infof("EncodeOptionsQuery failed. Err: %v\n", err)
+		klog.V(6).Infof("rest.doCommonReader LEAVE\n")
+		return err
 	}
-	// end
 
-	URI := version.GetAsyncAPI(apiURI, baseName)
-	if len(params) > 1 {
-		URI = version.GetAsyncAPI(apiURI, baseName, params)
+	URI := version.GetAsyncAPI(apiURI, url.QueryEscape(baseName))
+	if len(params) > 0 {
+		URI = fmt.Sprintf("%s&%s", URI, params.Encode())
 	}
 	klog.V(6).Infof("URI: %s\n", URI)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", URI, file)
+	var body io.Reader = r
+	if readerOptions.Progress != nil {
+		body = &progressReader{
+			reader:   r,
+			total:    readerOptions.ContentLength,
+			progress: readerOptions.Progress,
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", URI, body)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+		klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 		return err
 	}
 
@@ -432,6 +504,13 @@ func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath string, opti
 		}
 	}
 
+	if readerOptions.ContentLength > 0 {
+		req.ContentLength = readerOptions.ContentLength
+	}
+	if len(readerOptions.ContentType) > 0 {
+		req.Header.Set("Content-Type", readerOptions.ContentType)
+	}
+
 	req.Header.Set("Accept", "application/json")
 	if c.auth != nil && c.auth.AccessToken != "" {
 		req.Header.Set("Authorization", "Bearer "+c.auth.AccessToken)
@@ -447,10 +526,10 @@ func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath string, opti
 			detail, err := io.ReadAll(res.Body)
 			if err != nil {
 				klog.V(4).Infof("io.ReadAll failed. Err: %e\n", err)
-				klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+				klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 				return err
 			}
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			return fmt.Errorf("%s: %s", res.Status, bytes.TrimSpace(detail))
 		default:
 			return &StatusError{res}
@@ -458,36 +537,36 @@ func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath string, opti
 
 		if resBody == nil {
 			klog.V(4).Infof("resBody == nil\n")
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			return nil
 		}
 
 		switch b := resBody.(type) {
 		case *RawResponse:
 			klog.V(4).Infof("RawResponse\n")
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			return res.Write(b)
 		case io.Writer:
 			klog.V(4).Infof("io.Writer\n")
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			_, err := io.Copy(b, res.Body)
 			return err
 		default:
 			klog.V(4).Infof("json.NewDecoder\n")
 			d := json.NewDecoder(res.Body)
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			return d.Decode(resBody)
 		}
 	})
 
 	if err != nil {
 		klog.V(1).Infof("err = c.Client.Do failed. Err: %v\n", err)
-		klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+		klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 		return err
 	}
 
-	klog.V(3).Infof("rest.doCommonFile Succeeded\n")
-	klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+	klog.V(3).Infof("rest.doCommonReader Succeeded\n")
+	klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 	return nil
 }
 
@@ -515,14 +594,7 @@ func (c *Client) DoURL(ctx context.Context, options interfaces.AsyncOptions, res
 	klog.V(3).Infof("extension: %s\n", extension)
 
 	// is audio?
-	switch extension {
-	case common.AudioTypeMP3:
-		klog.V(3).Infof("IsAudio = TRUE\n")
-		return c.doAudioURL(ctx, options, resBody)
-	case common.AudioTypeMpeg:
-		klog.V(3).Infof("IsAudio = TRUE\n")
-		return c.doAudioURL(ctx, options, resBody)
-	case common.AudioTypeWav:
+	if mediaType, ok := common.MediaTypeByExtension(extension); ok && mediaType.IsAudio {
 		klog.V(3).Infof("IsAudio = TRUE\n")
 		return c.doAudioURL(ctx, options, resBody)
 	}
@@ -562,15 +634,14 @@ func (c *Client) doCommonURL(ctx context.Context, apiURI string, options interfa
 	URI := version.GetAsyncAPI(apiURI)
 	klog.V(6).Infof("URI: %s\n", URI)
 
-	var buf bytes.Buffer
-	err := json.NewEncoder(&buf).Encode(options)
+	data, err := EncodeOptionsJSON(options)
 	if err != nil {
-		klog.V(1).Infof("json.NewEncoder().Encode() failed. Err: %v\n", err)
+		klog.V(1).Infof("EncodeOptionsJSON failed. Err: %v\n", err)
 		klog.V(6).Infof("rest.doCommonURL LEAVE\n")
 		return err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", URI, &buf)
+	req, err := http.NewRequestWithContext(ctx, "POST", URI, bytes.NewReader(data))
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
 		klog.V(6).Infof("rest.doCommonURL LEAVE\n")
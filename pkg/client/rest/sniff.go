@@ -0,0 +1,89 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import (
+	"bytes"
+
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
+// sniffLen is enough of the header to find the codec IDs near the start of a Matroska/WebM file
+const sniffLen int = 4096
+
+var (
+	matroskaVideoCodecs = [][]byte{[]byte("V_VP8"), []byte("V_VP9"), []byte("V_AV1"), []byte("V_MPEG4"), []byte("V_MPEGH")}
+	matroskaAudioCodecs = [][]byte{[]byte("A_OPUS"), []byte("A_VORBIS"), []byte("A_AAC"), []byte("A_MPEG"), []byte("A_FLAC"), []byte("A_PCM")}
+)
+
+// DetectMediaType identifies the media format from the first bytes of the file
+func DetectMediaType(header []byte) (common.MediaType, bool) {
+	switch {
+	case len(header) >= 12 && bytes.HasPrefix(header, []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WAVE")):
+		return common.MediaTypeByExtension(common.AudioTypeWav)
+	case len(header) >= 12 && bytes.HasPrefix(header, []byte("RIFF")) && bytes.Equal(header[8:12], []byte("AVI ")):
+		return common.MediaTypeByExtension(common.VideoTypeAvi)
+	case bytes.HasPrefix(header, []byte("fLaC")):
+		return common.MediaTypeByExtension(common.AudioTypeFlac)
+	case bytes.HasPrefix(header, []byte("#!AMR")):
+		return common.MediaTypeByExtension(common.AudioTypeAmr)
+	case bytes.HasPrefix(header, []byte("OggS")):
+		if len(header) >= 36 && bytes.Equal(header[28:36], []byte("OpusHead")) {
+			return common.MediaTypeByExtension(common.AudioTypeOpus)
+		}
+		if len(header) >= 35 && bytes.Equal(header[28:35], []byte("\x80theora")) {
+			return common.MediaTypeByExtension(common.VideoTypeOgv)
+		}
+		return common.MediaTypeByExtension(common.AudioTypeOgg)
+	case len(header) >= 12 && bytes.Equal(header[4:8], []byte("ftyp")):
+		switch string(header[8:12]) {
+		case "M4A ", "M4B ", "M4P ":
+			return common.MediaTypeByExtension(common.AudioTypeM4a)
+		case "qt  ":
+			return common.MediaTypeByExtension(common.VideoTypeMov)
+		}
+		return common.MediaTypeByExtension(common.VideoTypeMP4)
+	case bytes.HasPrefix(header, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		return detectMatroska(header)
+	case bytes.HasPrefix(header, []byte{0x00, 0x00, 0x01, 0xBA}), bytes.HasPrefix(header, []byte{0x00, 0x00, 0x01, 0xB3}):
+		return common.MediaTypeByExtension(common.VideoTypeMpg)
+	case len(header) > 188 && header[0] == 0x47 && header[188] == 0x47:
+		return common.MediaTypeByExtension(common.VideoTypeTs)
+	case bytes.HasPrefix(header, []byte("ID3")):
+		return common.MediaTypeByExtension(common.AudioTypeMP3)
+	case len(header) >= 2 && header[0] == 0xFF && header[1]&0xF6 == 0xF0:
+		// ADTS sync word with layer bits 00
+		return common.MediaTypeByExtension(common.AudioTypeAac)
+	case len(header) >= 2 && header[0] == 0xFF && header[1]&0xE0 == 0xE0 && header[1]&0x06 != 0:
+		// MPEG audio frame sync
+		return common.MediaTypeByExtension(common.AudioTypeMP3)
+	}
+
+	return common.MediaType{}, false
+}
+
+// detectMatroska distinguishes audio only WebM/Matroska from video using the track codec IDs
+func detectMatroska(header []byte) (common.MediaType, bool) {
+	isWebm := bytes.Contains(header, []byte("webm"))
+
+	for _, codec := range matroskaVideoCodecs {
+		if bytes.Contains(header, codec) {
+			if isWebm {
+				return common.MediaTypeByExtension(common.VideoTypeWebm)
+			}
+			return common.MediaTypeByExtension(common.VideoTypeMkv)
+		}
+	}
+	for _, codec := range matroskaAudioCodecs {
+		if bytes.Contains(header, codec) {
+			return common.MediaTypeByExtension(common.AudioTypeWeba)
+		}
+	}
+
+	// no codec found in the header, assume video
+	if isWebm {
+		return common.MediaTypeByExtension(common.VideoTypeWebm)
+	}
+	return common.MediaTypeByExtension(common.VideoTypeMkv)
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package symbl
+
+import (
+	klog "k8s.io/klog/v2"
+
+	rtinterfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/streaming/v1/interfaces"
+	wav "github.com/dvonthenen/symbl-go-sdk/pkg/audio/wav"
+)
+
+func (rc *recordingCallback) InitializedConversation(im *rtinterfaces.InitializationMessage) error {
+	rc.recording.open(im.Message.Data.ConversationID)
+	return rc.InsightCallback.InitializedConversation(im)
+}
+
+// start enables recording, audio is held in memory until open is called
+func (r *recording) start(dir string, cfg wav.Config) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dir = dir
+	r.cfg = cfg
+	r.active = true
+}
+
+// open creates <dir>/<conversationId>.wav and flushes the audio sent so far
+func (r *recording) open(conversationId string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.active || r.writer != nil {
+		return
+	}
+
+	writer, err := wav.NewForConversation(r.dir, conversationId, r.cfg)
+	if err != nil {
+		klog.V(1).Infof("wav.NewForConversation failed. Err: %v\n", err)
+		return
+	}
+	r.writer = writer
+	klog.V(3).Infof("Recording to %s\n", writer.GetPath())
+
+	if len(r.pending) > 0 {
+		_, err = r.writer.Write(r.pending)
+		if err != nil {
+			klog.V(1).Infof("recorder.Write failed. Err: %v\n", err)
+		}
+	}
+	r.pending = nil
+}
+
+func (r *recording) write(p []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.active {
+		return
+	}
+	if r.writer == nil {
+		r.pending = append(r.pending, p...)
+		return
+	}
+
+	_, err := r.writer.Write(p)
+	if err != nil {
+		klog.V(1).Infof("recorder.Write failed. Err: %v\n", err)
+	}
+}
+
+func (r *recording) path() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.writer == nil {
+		return ""
+	}
+	return r.writer.GetPath()
+}
+
+// close finalizes the recording. Audio sent before the platform assigned a conversationId
+// is saved under fallbackId so it is not lost.
+func (r *recording) close(fallbackId string) {
+	r.mu.Lock()
+	pending := len(r.pending) > 0
+	r.mu.Unlock()
+
+	if pending {
+		klog.V(1).Infof("conversationId was not received, recording as %s\n", fallbackId)
+		r.open(fallbackId)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.active = false
+	if r.writer == nil {
+		return
+	}
+
+	err := r.writer.Close()
+	if err != nil {
+		klog.V(1).Infof("recorder.Close failed. Err: %v\n", err)
+	}
+}
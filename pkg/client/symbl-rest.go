@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"os"
 	"time"
@@ -138,6 +139,10 @@ func (c *RestClient) DoFileWithOptions(ctx context.Context, filePath string, opt
 	return c.Client.DoFile(ctx, filePath, options, resBody)
 }
 
+func (c *RestClient) DoReaderWithOptions(ctx context.Context, r io.Reader, readerOptions asyncinterfaces.ReaderOptions, options asyncinterfaces.AsyncOptions, resBody interface{}) error {
+	return c.Client.DoReader(ctx, r, readerOptions, options, resBody)
+}
+
 func (c *RestClient) DoURLWithOptions(ctx context.Context, options asyncinterfaces.AsyncOptions, resBody interface{}) error {
 	return c.Client.DoURL(ctx, options, resBody)
 }
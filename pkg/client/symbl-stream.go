@@ -5,12 +5,14 @@ package symbl
 
 import (
 	"context"
+	"strings"
 
 	"github.com/google/uuid"
 	klog "k8s.io/klog/v2"
 
 	streaming "github.com/dvonthenen/symbl-go-sdk/pkg/api/streaming/v1"
 	version "github.com/dvonthenen/symbl-go-sdk/pkg/api/version"
+	wav "github.com/dvonthenen/symbl-go-sdk/pkg/audio/wav"
 	cfginterfaces "github.com/dvonthenen/symbl-go-sdk/pkg/client/interfaces"
 	stream "github.com/dvonthenen/symbl-go-sdk/pkg/client/stream"
 )
@@ -20,6 +22,8 @@ const (
 	defaultSampleRateHertz     int     = 16000
 	defaultUserID              string  = "user@email.com"
 	defaultUserName            string  = "Jane Doe"
+	defaultRecordingChannels   int     = 1
+	encodingLinear16           string  = "LINEAR16"
 )
 
 func GetDefaultConfig() *cfginterfaces.StreamingConfig {
@@ -29,7 +33,7 @@ func GetDefaultConfig() *cfginterfaces.StreamingConfig {
 	config.InsightTypes = []string{"topic", "question", "action_item", "follow_up"}
 	config.Config.MeetingTitle = "my-meeting"
 	config.Config.ConfidenceThreshold = defaultConfidenceThreshold
-	config.Config.SpeechRecognition.Encoding = "LINEAR16"
+	config.Config.SpeechRecognition.Encoding = encodingLinear16
 	config.Config.SpeechRecognition.SampleRateHertz = defaultSampleRateHertz
 	config.Speaker.Name = defaultUserName
 	config.Speaker.UserID = defaultUserID
@@ -82,8 +86,24 @@ func NewStreamClient(ctx context.Context, options StreamingOptions) (*StreamClie
 	streamPath := version.GetStreamingAPI(version.StreamPath, conversationId)
 	klog.V(4).Infof("streamPath: %s\n", streamPath)
 
+	// the recording is named after the conversationId the platform assigns
+	var rec *recording
+	callback := options.Callback
+	if len(options.RecordingDir) > 0 {
+		if options.Callback == nil {
+			klog.V(1).Infof("Recording requires a Callback\n")
+			klog.V(6).Infof("NewStreamClient LEAVE\n")
+			return nil, ErrInvalidInput
+		}
+		rec = &recording{}
+		callback = &recordingCallback{
+			InsightCallback: options.Callback,
+			recording:       rec,
+		}
+	}
+
 	// init symbl websocket message router
-	symblStreaming := streaming.New(options.Callback)
+	symblStreaming := streaming.New(callback)
 
 	// create client
 	creds := stream.Credentials{
@@ -107,6 +127,7 @@ func NewStreamClient(ctx context.Context, options StreamingOptions) (*StreamClie
 		restClient,
 		symblStreaming,
 		&options,
+		rec,
 	}
 
 	klog.V(3).Infof("NewStreamClient Succeeded\n")
@@ -125,6 +146,31 @@ func (sc *StreamClient) Start() error {
 	}
 	sc.options.SymblConfig.Type = streaming.TypeRequestStart
 
+	// local recording of the audio sent
+	if sc.recording != nil {
+		speechRecognition := sc.options.SymblConfig.Config.SpeechRecognition
+		if len(speechRecognition.Encoding) > 0 && !strings.EqualFold(speechRecognition.Encoding, encodingLinear16) {
+			klog.V(1).Infof("Recording does not support encoding %s\n", speechRecognition.Encoding)
+			klog.V(6).Infof("Start LEAVE\n")
+			return ErrRecordingEncodingNotSupported
+		}
+
+		sampleRate := speechRecognition.SampleRateHertz
+		if sampleRate == 0 {
+			sampleRate = defaultSampleRateHertz
+		}
+		channels := sc.options.RecordingChannels
+		if channels == 0 {
+			channels = defaultRecordingChannels
+		}
+
+		sc.recording.start(sc.options.RecordingDir, wav.Config{
+			SampleRate:    sampleRate,
+			Channels:      channels,
+			BitsPerSample: wav.DefaultBitsPerSample,
+		})
+	}
+
 	// establish connection
 	wsConnection := sc.Connect()
 	if wsConnection == nil {
@@ -146,10 +192,74 @@ func (sc *StreamClient) Start() error {
 	return nil
 }
 
+// Modify changes the trackers, custom vocabulary, insight types or speech recognition settings
+// of a session that has already been started. The platform acknowledges the change with a
+// session_modified message which is passed to the InsightCallback.
+func (sc *StreamClient) Modify(config cfginterfaces.ModifyConfig) error {
+	klog.V(6).Infof("Modify ENTER\n")
+
+	if sc.options.SymblConfig == nil {
+		klog.V(1).Infof("Config is null\n")
+		klog.V(6).Infof("Modify LEAVE\n")
+		return ErrInvalidInput
+	}
+	if len(config.InsightTypes) == 0 && len(config.CustomVocabulary) == 0 &&
+		len(config.Trackers) == 0 && config.SpeechRecognition == nil {
+		klog.V(1).Infof("ModifyConfig contains no changes\n")
+		klog.V(6).Infof("Modify LEAVE\n")
+		return ErrInvalidInput
+	}
+	config.Type = streaming.TypeRequestModify
+
+	// write modify request to Platform
+	err := sc.WriteJSON(config)
+	if err != nil {
+		klog.V(1).Infof("wsClient.WriteJSON failed. Err: %v\n", err)
+		klog.V(6).Infof("Modify LEAVE\n")
+		return err
+	}
+
+	// keep the local copy of the config in sync
+	if len(config.InsightTypes) > 0 {
+		sc.options.SymblConfig.InsightTypes = config.InsightTypes
+	}
+	if len(config.CustomVocabulary) > 0 {
+		sc.options.SymblConfig.CustomVocabulary = config.CustomVocabulary
+	}
+	if len(config.Trackers) > 0 {
+		sc.options.SymblConfig.Trackers = config.Trackers
+	}
+	if config.SpeechRecognition != nil {
+		sc.options.SymblConfig.Config.SpeechRecognition = *config.SpeechRecognition
+	}
+
+	klog.V(3).Infof("Modify Succeeded\n")
+	klog.V(6).Infof("Modify LEAVE\n")
+	return nil
+}
+
 func (sc *StreamClient) GetConversationId() string {
 	return sc.uuid
 }
 
+// GetRecordingPath returns the local WAV recording for this session or empty if not recording.
+// The file is created once the platform has assigned the conversationId.
+func (sc *StreamClient) GetRecordingPath() string {
+	if sc.recording == nil {
+		return ""
+	}
+	return sc.recording.path()
+}
+
+// Write sends audio to the platform and, when recording, keeps a copy of exactly what was sent
+func (sc *StreamClient) Write(p []byte) (int, error) {
+	byteLen, err := sc.WebSocketClient.Write(p)
+	if byteLen > 0 && sc.recording != nil {
+		sc.recording.write(p[:byteLen])
+	}
+	return byteLen, err
+}
+
 func (sc *StreamClient) Stop() {
 	// signal stop to Symbl Platform
 	stopMsg := &streaming.MessageType{
@@ -163,4 +273,9 @@ func (sc *StreamClient) Stop() {
 
 	// stop websocket
 	sc.WebSocketClient.Stop()
+
+	// finalize recording
+	if sc.recording != nil {
+		sc.recording.close(sc.uuid)
+	}
 }
@@ -5,8 +5,10 @@ package symbl
 
 import (
 	"fmt"
+	"sync"
 
 	rtinterfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/streaming/v1/interfaces"
+	wav "github.com/dvonthenen/symbl-go-sdk/pkg/audio/wav"
 	cfginterfaces "github.com/dvonthenen/symbl-go-sdk/pkg/client/interfaces"
 	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/client/interfaces"
 	rest "github.com/dvonthenen/symbl-go-sdk/pkg/client/rest"
@@ -27,11 +29,13 @@ type RestClient struct {
 	Streaming Client
 */
 type StreamingOptions struct {
-	UUID           string
-	ProxyAddress   string
-	SymblConfig    *cfginterfaces.StreamingConfig
-	Callback       rtinterfaces.InsightCallback
-	SkipServerAuth bool
+	UUID              string
+	ProxyAddress      string
+	SymblConfig       *cfginterfaces.StreamingConfig
+	Callback          rtinterfaces.InsightCallback
+	SkipServerAuth    bool
+	RecordingDir      string // when set, audio sent via Write is recorded to <RecordingDir>/<conversationId>.wav
+	RecordingChannels int    // channels in the audio passed to Write, defaults to 1
 }
 
 type StreamClient struct {
@@ -41,7 +45,28 @@ type StreamClient struct {
 	restClient     *RestClient
 	symblStreaming stream.WebSocketMessageCallback
 
-	options *StreamingOptions
+	options   *StreamingOptions
+	recording *recording
+}
+
+// recording holds the audio sent until the platform assigns the conversationId, which
+// names the WAV file, then writes through to it
+type recording struct {
+	dir    string
+	cfg    wav.Config
+	active bool
+
+	mu      sync.Mutex
+	writer  *wav.Writer
+	pending []byte
+}
+
+// recordingCallback starts the recording once the conversation is created and otherwise
+// passes every message to the user's callback
+type recordingCallback struct {
+	rtinterfaces.InsightCallback
+
+	recording *recording
 }
 
 /*
@@ -0,0 +1,31 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"errors"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+)
+
+const (
+	// transcript change operations
+	OpInsert  string = "insert"
+	OpDelete  string = "delete"
+	OpReplace string = "replace"
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// DiffInsights are the insights fetched by CompareConversations
+	DiffInsights = []string{
+		async.InsightMessages,
+		async.InsightTopics,
+		async.InsightQuestions,
+		async.InsightActionItems,
+		async.InsightTrackers,
+	}
+)
@@ -0,0 +1,251 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	klog "k8s.io/klog/v2"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// CompareConversations fetches DiffInsights for both conversations and compares them.
+// Insights which can't be fetched for either conversation are skipped and listed in
+// Report.Skipped.
+func CompareConversations(ctx context.Context, client *async.Client, beforeId, afterId string, options Options) (*Report, error) {
+	klog.V(6).Infof("diff.CompareConversations ENTER\n")
+
+	// checks
+	if client == nil || len(beforeId) == 0 || len(afterId) == 0 {
+		klog.V(1).Infof("client or conversation ID is empty\n")
+		klog.V(6).Infof("diff.CompareConversations LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	before, err := client.FetchInsights(ctx, beforeId, DiffInsights)
+	if err != nil && err != async.ErrPartialInsights {
+		klog.V(1).Infof("FetchInsights %s failed. Err: %v\n", beforeId, err)
+		klog.V(6).Infof("diff.CompareConversations LEAVE\n")
+		return nil, err
+	}
+
+	after, err := client.FetchInsights(ctx, afterId, DiffInsights)
+	if err != nil && err != async.ErrPartialInsights {
+		klog.V(1).Infof("FetchInsights %s failed. Err: %v\n", afterId, err)
+		klog.V(6).Infof("diff.CompareConversations LEAVE\n")
+		return nil, err
+	}
+
+	report, err := Compare(before, after, options)
+	if err != nil {
+		klog.V(1).Infof("Compare failed. Err: %v\n", err)
+		klog.V(6).Infof("diff.CompareConversations LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("diff.CompareConversations Succeeded\n")
+	klog.V(6).Infof("diff.CompareConversations LEAVE\n")
+	return report, nil
+}
+
+// Compare reports the differences between two result sets, typically the same recording
+// processed with different AsyncOptions. An insight in the Errors of either result set is
+// not compared, since everything on the other side would show as added or removed, and is
+// listed in Report.Skipped instead.
+func Compare(before, after *async.ConversationInsights, options Options) (*Report, error) {
+	klog.V(6).Infof("diff.Compare ENTER\n")
+
+	// checks
+	if before == nil || after == nil {
+		klog.V(1).Infof("before or after is nil\n")
+		klog.V(6).Infof("diff.Compare LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	report := &Report{
+		BeforeConversationID: before.ConversationID,
+		AfterConversationID:  after.ConversationID,
+	}
+
+	// an insight which failed on either side can't be compared
+	failed := func(insight string) bool {
+		if before.Errors[insight] == nil && after.Errors[insight] == nil {
+			return false
+		}
+		klog.V(3).Infof("Skipping %s, it could not be fetched\n", insight)
+		report.Skipped = append(report.Skipped, insight)
+		return true
+	}
+
+	switch {
+	case failed(async.InsightMessages):
+	case before.Messages == nil || after.Messages == nil:
+		klog.V(3).Infof("Skipping transcript, messages are missing\n")
+		report.Skipped = append(report.Skipped, async.InsightMessages)
+	default:
+		report.Transcript = compareTranscripts(before.Messages, after.Messages, options)
+		klog.V(4).Infof("Transcript: %d changes\n", len(report.Transcript.Changes))
+	}
+
+	if !failed(async.InsightTopics) {
+		report.Topics = compareTexts(topicTexts(before.Topics), topicTexts(after.Topics), options)
+	}
+	if !failed(async.InsightQuestions) {
+		report.Questions = compareTexts(questionTexts(before.Questions), questionTexts(after.Questions), options)
+	}
+	if !failed(async.InsightActionItems) {
+		report.ActionItems = compareTexts(actionItemTexts(before.ActionItems), actionItemTexts(after.ActionItems), options)
+	}
+	if !failed(async.InsightTrackers) {
+		report.Trackers = compareTrackers(trackerChanges(before.Trackers), trackerChanges(after.Trackers), options)
+	}
+
+	klog.V(6).Infof("diff.Compare LEAVE\n")
+	return report, nil
+}
+
+// Changed is true when any difference was found
+func (r *Report) Changed() bool {
+	if r.Transcript != nil && len(r.Transcript.Changes) > 0 {
+		return true
+	}
+	for _, texts := range []TextDiff{r.Topics, r.Questions, r.ActionItems} {
+		if len(texts.Added) > 0 || len(texts.Removed) > 0 {
+			return true
+		}
+	}
+	return len(r.Trackers.Added) > 0 || len(r.Trackers.Removed) > 0
+}
+
+// compareTexts matches texts as a multiset so duplicates are counted
+func compareTexts(before, after []string, options Options) TextDiff {
+	var result TextDiff
+
+	remaining := make(map[string]int)
+	for _, text := range before {
+		remaining[normalize(text, options)]++
+	}
+	for _, text := range after {
+		key := normalize(text, options)
+		if remaining[key] > 0 {
+			remaining[key]--
+			result.Unchanged++
+			continue
+		}
+		result.Added = append(result.Added, text)
+	}
+	for _, text := range before {
+		key := normalize(text, options)
+		if remaining[key] > 0 {
+			remaining[key]--
+			result.Removed = append(result.Removed, text)
+		}
+	}
+
+	return result
+}
+
+func compareTrackers(before, after []TrackerChange, options Options) TrackerDiff {
+	var result TrackerDiff
+
+	key := func(change TrackerChange) string {
+		return strings.Join([]string{change.Tracker, change.Type, normalize(change.Value, options), normalize(change.Text, options)}, "\x00")
+	}
+
+	remaining := make(map[string]int)
+	for _, change := range before {
+		remaining[key(change)]++
+	}
+	for _, change := range after {
+		k := key(change)
+		if remaining[k] > 0 {
+			remaining[k]--
+			result.Unchanged++
+			continue
+		}
+		result.Added = append(result.Added, change)
+	}
+	for _, change := range before {
+		k := key(change)
+		if remaining[k] > 0 {
+			remaining[k]--
+			result.Removed = append(result.Removed, change)
+		}
+	}
+
+	return result
+}
+
+func topicTexts(result *interfaces.TopicResult) []string {
+	if result == nil {
+		return nil
+	}
+	texts := make([]string, 0, len(result.Topics))
+	for _, topic := range result.Topics {
+		texts = append(texts, topic.Text)
+	}
+	return texts
+}
+
+func questionTexts(result *interfaces.QuestionResult) []string {
+	if result == nil {
+		return nil
+	}
+	texts := make([]string, 0, len(result.Questions))
+	for _, question := range result.Questions {
+		texts = append(texts, question.Text)
+	}
+	return texts
+}
+
+func actionItemTexts(result *interfaces.ActionItemResult) []string {
+	if result == nil {
+		return nil
+	}
+	texts := make([]string, 0, len(result.ActionItems))
+	for _, actionItem := range result.ActionItems {
+		texts = append(texts, actionItem.Text)
+	}
+	return texts
+}
+
+// trackerChanges flattens the matches into one entry per matched message
+func trackerChanges(result *interfaces.TrackerResult) []TrackerChange {
+	if result == nil {
+		return nil
+	}
+
+	var changes []TrackerChange
+	for _, match := range result.Matches {
+		for _, ref := range match.MessageRefs {
+			changes = append(changes, TrackerChange{
+				Tracker: result.Name,
+				Type:    match.Type,
+				Value:   match.Value,
+				Text:    ref.Text,
+			})
+		}
+	}
+	return changes
+}
+
+// normalize builds the comparison key of a text
+func normalize(text string, options Options) string {
+	if !options.CaseSensitive {
+		text = strings.ToLower(text)
+	}
+	if !options.KeepPunctuation {
+		text = strings.Map(func(r rune) rune {
+			if unicode.IsPunct(r) {
+				return -1
+			}
+			return r
+		}, text)
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,170 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+import (
+	"strings"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+func compareTranscripts(before, after *interfaces.MessageResult, options Options) *TranscriptDiff {
+	a := transcriptWords(before, options)
+	b := transcriptWords(after, options)
+
+	result := &TranscriptDiff{
+		WordsBefore: len(a),
+		WordsAfter:  len(b),
+	}
+
+	var current *WordChange
+	flush := func() {
+		if current == nil {
+			return
+		}
+		switch {
+		case len(current.Before) == 0:
+			current.Op = OpInsert
+		case len(current.After) == 0:
+			current.Op = OpDelete
+		default:
+			current.Op = OpReplace
+		}
+		if current.BeforeIndex < len(a) {
+			current.BeforeMessageID = a[current.BeforeIndex].messageID
+		}
+		if current.AfterIndex < len(b) {
+			current.AfterMessageID = b[current.AfterIndex].messageID
+		}
+
+		substitutions := len(current.Before)
+		if len(current.After) < substitutions {
+			substitutions = len(current.After)
+		}
+		result.Substitutions += substitutions
+		result.Deletions += len(current.Before) - substitutions
+		result.Insertions += len(current.After) - substitutions
+
+		result.Changes = append(result.Changes, *current)
+		current = nil
+	}
+
+	for _, op := range editScript(a, b) {
+		if op.equal {
+			flush()
+			continue
+		}
+		if current == nil {
+			current = &WordChange{
+				BeforeIndex: op.before,
+				AfterIndex:  op.after,
+			}
+		}
+		if op.insert {
+			current.After = append(current.After, b[op.after].text)
+		} else {
+			current.Before = append(current.Before, a[op.before].text)
+		}
+	}
+	flush()
+
+	return result
+}
+
+// transcriptWords splits the messages into words, dropping tokens which are only punctuation
+// when punctuation is ignored
+func transcriptWords(result *interfaces.MessageResult, options Options) []word {
+	var words []word
+	for _, message := range result.Messages {
+		for _, text := range strings.Fields(message.Text) {
+			key := normalize(text, options)
+			if len(key) == 0 {
+				continue
+			}
+			words = append(words, word{
+				text:      text,
+				key:       key,
+				messageID: message.ID,
+			})
+		}
+	}
+	return words
+}
+
+// editScript computes a shortest edit script between a and b using Myers' O(ND) algorithm.
+// Only the diagonals reachable at each step are kept, so memory grows with the square of the
+// number of edits rather than with the length of the transcripts.
+func editScript(a, b []word) []editOp {
+	n, m := len(a), len(b)
+
+	// frontier[k+offset] is the furthest x reached on diagonal k = x - y
+	offset := n + m + 1
+	frontier := make([]int, 2*offset+1)
+	var trace [][]int
+
+	done := false
+	for d := 0; d <= n+m && !done; d++ {
+		// snapshot diagonals -d-1..d+1 before this step
+		snapshot := make([]int, 2*d+3)
+		copy(snapshot, frontier[offset-d-1:offset+d+2])
+		trace = append(trace, snapshot)
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && frontier[offset+k-1] < frontier[offset+k+1]) {
+				x = frontier[offset+k+1]
+			} else {
+				x = frontier[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x].key == b[y].key {
+				x++
+				y++
+			}
+			frontier[offset+k] = x
+			if x >= n && y >= m {
+				done = true
+				break
+			}
+		}
+	}
+
+	// walk back from the end
+	var ops []editOp
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, editOp{equal: true, before: x, after: y})
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, editOp{insert: true, before: x, after: prevY})
+			} else {
+				ops = append(ops, editOp{before: prevX, after: y})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	// reverse into forward order
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package diff
+
+// Options controls how text is compared. By default words and insights are compared ignoring
+// case and punctuation, while the report keeps the original text.
+type Options struct {
+	CaseSensitive   bool
+	KeepPunctuation bool
+}
+
+// Report describes what changed between two processing runs of the same recording. Insights
+// are compared by their text, so IDs which differ between conversations are ignored.
+type Report struct {
+	BeforeConversationID string
+	AfterConversationID  string
+
+	// Transcript is nil when either run is missing its messages
+	Transcript  *TranscriptDiff
+	Topics      TextDiff
+	Questions   TextDiff
+	ActionItems TextDiff
+	Trackers    TrackerDiff
+
+	// Skipped lists the insights, such as async.InsightTopics, which were not compared because
+	// either run is missing them. Their diffs are left empty.
+	Skipped []string
+}
+
+// TranscriptDiff is a word level comparison of the two transcripts
+type TranscriptDiff struct {
+	WordsBefore int
+	WordsAfter  int
+
+	// word counts by operation; a replacement of n words by m words counts min(n, m)
+	// substitutions and the remainder as insertions or deletions
+	Substitutions int
+	Insertions    int
+	Deletions     int
+
+	Changes []WordChange
+}
+
+// WordChange is a run of consecutive words which differ. Indexes are word positions in each
+// transcript. Before is empty for OpInsert and After is empty for OpDelete.
+type WordChange struct {
+	Op          string
+	BeforeIndex int
+	AfterIndex  int
+	Before      []string
+	After       []string
+
+	// messages containing the first word of each side of the change
+	BeforeMessageID string
+	AfterMessageID  string
+}
+
+// TextDiff lists insight texts only found in one of the runs
+type TextDiff struct {
+	Added     []string
+	Removed   []string
+	Unchanged int
+}
+
+// TrackerDiff lists tracker matches only found in one of the runs
+type TrackerDiff struct {
+	Added     []TrackerChange
+	Removed   []TrackerChange
+	Unchanged int
+}
+
+// TrackerChange is a tracker match on a message
+type TrackerChange struct {
+	Tracker string
+	Type    string
+	Value   string
+	Text    string
+}
+
+// word is a transcript word with its comparison key
+type word struct {
+	text      string
+	key       string
+	messageID string
+}
+
+// editOp is a single step of an edit script
+type editOp struct {
+	equal  bool
+	insert bool
+	before int
+	after  int
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package eval
+
+import (
+	"errors"
+)
+
+const (
+	// alignment operations
+	OpCorrect      string = "correct"
+	OpSubstitution string = "substitution"
+	OpInsertion    string = "insertion"
+	OpDeletion     string = "deletion"
+
+	// MaxAlignmentCells bounds the reference x hypothesis word matrix used to build the word
+	// alignment. Larger inputs are still scored but Alignment is left empty.
+	MaxAlignmentCells int = 16 * 1024 * 1024
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+)
+
+var (
+	// DefaultRules are applied when Options.Rules is nil
+	DefaultRules = []Rule{
+		Lowercase,
+		StripPunctuation,
+	}
+
+	// CommonFillers are removed by RemoveFillers when no words are given
+	CommonFillers = []string{"um", "umm", "uh", "uhh", "er", "erm", "ah", "hmm", "mm"}
+)
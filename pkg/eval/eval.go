@@ -0,0 +1,215 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package eval
+
+import (
+	"strings"
+
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	streaminginterfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/streaming/v1/interfaces"
+)
+
+// Evaluate aligns hypothesis against reference and computes the word and character error
+// rates after normalizing both with the same rules
+func Evaluate(hypothesis, reference string, options Options) (*Result, error) {
+	klog.V(6).Infof("eval.Evaluate ENTER\n")
+
+	ref := Normalize(reference, options.Rules)
+	hyp := Normalize(hypothesis, options.Rules)
+	if len(ref) == 0 {
+		klog.V(1).Infof("reference is empty after normalization\n")
+		klog.V(6).Infof("eval.Evaluate LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	// the word counts come from the alignment when there is one so the two always agree
+	result := &Result{}
+	if len(ref)*len(hyp) <= MaxAlignmentCells {
+		result.Alignment = align(ref, hyp)
+		result.Words = alignmentCounts(result.Alignment, len(ref), len(hyp))
+	} else {
+		klog.V(3).Infof("Skipping alignment of %d x %d words\n", len(ref), len(hyp))
+		result.Words = editCounts(ref, hyp)
+	}
+
+	// characters of the normalized text, with single spaces between words
+	refChars := strings.Split(strings.Join(ref, " "), "")
+	hypChars := strings.Split(strings.Join(hyp, " "), "")
+	if len(hyp) == 0 {
+		hypChars = nil
+	}
+	result.Characters = editCounts(refChars, hypChars)
+
+	result.WER = result.Words.ErrorRate
+	result.CER = result.Characters.ErrorRate
+
+	klog.V(4).Infof("WER: %.4f CER: %.4f\n", result.WER, result.CER)
+	klog.V(6).Infof("eval.Evaluate LEAVE\n")
+	return result, nil
+}
+
+// EvaluateMessages evaluates the transcript of a processed conversation
+func EvaluateMessages(result *interfaces.MessageResult, reference string, options Options) (*Result, error) {
+	if result == nil {
+		klog.V(1).Infof("result is nil\n")
+		return nil, ErrInvalidInput
+	}
+
+	texts := make([]string, 0, len(result.Messages))
+	for _, message := range result.Messages {
+		texts = append(texts, message.Text)
+	}
+
+	return Evaluate(strings.Join(texts, " "), reference, options)
+}
+
+// EvaluateStreamingMessages evaluates messages received from the streaming API. Messages with
+// an ID that was already seen replace the earlier version in place.
+func EvaluateStreamingMessages(messages []streaminginterfaces.Message, reference string, options Options) (*Result, error) {
+	var texts []string
+	index := make(map[string]int)
+
+	for _, message := range messages {
+		if i, ok := index[message.ID]; ok && len(message.ID) > 0 {
+			texts[i] = message.Payload.Content
+			continue
+		}
+		index[message.ID] = len(texts)
+		texts = append(texts, message.Payload.Content)
+	}
+
+	return Evaluate(strings.Join(texts, " "), reference, options)
+}
+
+// editCounts computes the Levenshtein distance between the token sequences, keeping only two
+// rows so long transcripts don't need the full matrix. Among paths of equal cost substitutions
+// are preferred over deletions and deletions over insertions.
+func editCounts(ref, hyp []string) Counts {
+	previous := make([]cell, len(hyp)+1)
+	current := make([]cell, len(hyp)+1)
+	for j := range previous {
+		previous[j] = cell{cost: j, insertions: j}
+	}
+
+	for i := 1; i <= len(ref); i++ {
+		current[0] = cell{cost: i, deletions: i}
+		for j := 1; j <= len(hyp); j++ {
+			diagonal := previous[j-1]
+			if ref[i-1] != hyp[j-1] {
+				diagonal.cost++
+				diagonal.substitutions++
+			}
+			best := diagonal
+
+			deletion := previous[j]
+			deletion.cost++
+			deletion.deletions++
+			if deletion.cost < best.cost {
+				best = deletion
+			}
+
+			insertion := current[j-1]
+			insertion.cost++
+			insertion.insertions++
+			if insertion.cost < best.cost {
+				best = insertion
+			}
+
+			current[j] = best
+		}
+		previous, current = current, previous
+	}
+
+	last := previous[len(hyp)]
+	counts := Counts{
+		Reference:     len(ref),
+		Hypothesis:    len(hyp),
+		Substitutions: last.substitutions,
+		Insertions:    last.insertions,
+		Deletions:     last.deletions,
+	}
+	counts.Correct = len(ref) - counts.Substitutions - counts.Deletions
+	if counts.Reference > 0 {
+		counts.ErrorRate = float64(last.cost) / float64(counts.Reference)
+	}
+	return counts
+}
+
+// alignmentCounts tallies the operations of an alignment
+func alignmentCounts(alignment []AlignedWord, refLen, hypLen int) Counts {
+	counts := Counts{
+		Reference:  refLen,
+		Hypothesis: hypLen,
+	}
+	for _, word := range alignment {
+		switch word.Op {
+		case OpCorrect:
+			counts.Correct++
+		case OpSubstitution:
+			counts.Substitutions++
+		case OpInsertion:
+			counts.Insertions++
+		case OpDeletion:
+			counts.Deletions++
+		}
+	}
+	if counts.Reference > 0 {
+		counts.ErrorRate = float64(counts.Substitutions+counts.Insertions+counts.Deletions) / float64(counts.Reference)
+	}
+	return counts
+}
+
+// align builds the word alignment from the full cost matrix
+func align(ref, hyp []string) []AlignedWord {
+	cols := len(hyp) + 1
+	cost := make([]int, (len(ref)+1)*cols)
+	for j := 0; j <= len(hyp); j++ {
+		cost[j] = j
+	}
+	for i := 1; i <= len(ref); i++ {
+		cost[i*cols] = i
+		for j := 1; j <= len(hyp); j++ {
+			best := cost[(i-1)*cols+j-1]
+			if ref[i-1] != hyp[j-1] {
+				best++
+			}
+			if c := cost[(i-1)*cols+j] + 1; c < best {
+				best = c
+			}
+			if c := cost[i*cols+j-1] + 1; c < best {
+				best = c
+			}
+			cost[i*cols+j] = best
+		}
+	}
+
+	var alignment []AlignedWord
+	i, j := len(ref), len(hyp)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && ref[i-1] == hyp[j-1] && cost[i*cols+j] == cost[(i-1)*cols+j-1]:
+			alignment = append(alignment, AlignedWord{Op: OpCorrect, Reference: ref[i-1], Hypothesis: hyp[j-1]})
+			i--
+			j--
+		case i > 0 && j > 0 && cost[i*cols+j] == cost[(i-1)*cols+j-1]+1:
+			alignment = append(alignment, AlignedWord{Op: OpSubstitution, Reference: ref[i-1], Hypothesis: hyp[j-1]})
+			i--
+			j--
+		case i > 0 && cost[i*cols+j] == cost[(i-1)*cols+j]+1:
+			alignment = append(alignment, AlignedWord{Op: OpDeletion, Reference: ref[i-1]})
+			i--
+		default:
+			alignment = append(alignment, AlignedWord{Op: OpInsertion, Hypothesis: hyp[j-1]})
+			j--
+		}
+	}
+
+	// reverse into reading order
+	for l, r := 0, len(alignment)-1; l < r; l, r = l+1, r-1 {
+		alignment[l], alignment[r] = alignment[r], alignment[l]
+	}
+	return alignment
+}
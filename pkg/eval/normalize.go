@@ -0,0 +1,89 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package eval
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Lowercase folds the text to lower case
+func Lowercase(text string) string {
+	return strings.ToLower(text)
+}
+
+// StripPunctuation removes punctuation, keeping apostrophes and hyphens inside words so
+// "don't" and "follow-up" stay single words
+func StripPunctuation(text string) string {
+	runes := []rune(text)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsPunct(r) {
+			inner := (r == '\'' || r == '\u2019' || r == '-') &&
+				i > 0 && i < len(runes)-1 &&
+				isWordRune(runes[i-1]) && isWordRune(runes[i+1])
+			if !inner {
+				b.WriteRune(' ')
+				continue
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// SplitHyphens treats hyphenated words as separate words
+func SplitHyphens(text string) string {
+	return strings.ReplaceAll(text, "-", " ")
+}
+
+// ReplaceWords returns a rule substituting whole words, for example to map "ok" to "okay" or
+// "gonna" to "going to". Matching is exact, so apply it after Lowercase when needed.
+func ReplaceWords(replacements map[string]string) Rule {
+	return func(text string) string {
+		words := strings.Fields(text)
+		for i, word := range words {
+			if replacement, ok := replacements[word]; ok {
+				words[i] = replacement
+			}
+		}
+		return strings.Join(words, " ")
+	}
+}
+
+// RemoveFillers returns a rule dropping filler words, defaulting to CommonFillers
+func RemoveFillers(fillers ...string) Rule {
+	if len(fillers) == 0 {
+		fillers = CommonFillers
+	}
+	remove := make(map[string]bool)
+	for _, filler := range fillers {
+		remove[filler] = true
+	}
+
+	return func(text string) string {
+		var kept []string
+		for _, word := range strings.Fields(text) {
+			if !remove[word] {
+				kept = append(kept, word)
+			}
+		}
+		return strings.Join(kept, " ")
+	}
+}
+
+// Normalize applies the rules to text and splits it into words
+func Normalize(text string, rules []Rule) []string {
+	if rules == nil {
+		rules = DefaultRules
+	}
+	for _, rule := range rules {
+		text = rule(text)
+	}
+	return strings.Fields(text)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
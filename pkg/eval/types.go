@@ -0,0 +1,58 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package eval
+
+// Rule normalizes text before it is compared. Rules are applied in order to both the
+// reference and the hypothesis.
+type Rule func(text string) string
+
+// Options configures Evaluate
+type Options struct {
+	// Rules defaults to DefaultRules when nil. Use an empty, non nil slice to compare the text
+	// as is.
+	Rules []Rule
+}
+
+// Counts is the edit distance breakdown at one granularity, words or characters
+type Counts struct {
+	Reference     int
+	Hypothesis    int
+	Correct       int
+	Substitutions int
+	Insertions    int
+	Deletions     int
+
+	// ErrorRate is (Substitutions + Insertions + Deletions) / Reference
+	ErrorRate float64
+}
+
+// Result of comparing a transcript against a reference
+type Result struct {
+	// WER and CER are Words.ErrorRate and Characters.ErrorRate
+	WER float64
+	CER float64
+
+	Words      Counts
+	Characters Counts
+
+	// Alignment pairs the normalized reference and hypothesis words, empty when the input
+	// exceeds MaxAlignmentCells
+	Alignment []AlignedWord
+}
+
+// AlignedWord is one step of the word alignment. Reference is empty for insertions and
+// Hypothesis is empty for deletions.
+type AlignedWord struct {
+	Op         string
+	Reference  string
+	Hypothesis string
+}
+
+// cell is a dynamic programming cell carrying the breakdown of its minimum cost path
+type cell struct {
+	cost          int
+	substitutions int
+	insertions    int
+	deletions     int
+}
@@ -0,0 +1,31 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package export
+
+import (
+	"errors"
+)
+
+const (
+	FormatSRT      string = "srt"
+	FormatWebVTT   string = "vtt"
+	FormatText     string = "txt"
+	FormatMarkdown string = "md"
+	FormatHTML     string = "html"
+	FormatCSV      string = "csv"
+
+	// DefaultWordsPerCue when splitting messages into subtitle cues using word timings
+	DefaultWordsPerCue int = 12
+
+	// UnknownSpeaker is used when a message can't be attributed to a member
+	UnknownSpeaker string = "Unknown Speaker"
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrUnsupportedFormat the export format is not supported
+	ErrUnsupportedFormat = errors.New("the export format is not supported")
+)
@@ -0,0 +1,255 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	validator "gopkg.in/go-playground/validator.v9"
+	klog "k8s.io/klog/v2"
+)
+
+// Render returns the Transcript in the requested format
+func Render(transcript *Transcript, options Options) ([]byte, error) {
+	var buf bytes.Buffer
+	err := Write(&buf, transcript, options)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Write renders the Transcript to w in the requested format
+func Write(w io.Writer, transcript *Transcript, options Options) error {
+	klog.V(6).Infof("export.Write ENTER\n")
+
+	// checks
+	if transcript == nil || w == nil {
+		klog.V(1).Infof("transcript or writer is nil\n")
+		klog.V(6).Infof("export.Write LEAVE\n")
+		return ErrInvalidInput
+	}
+
+	v := validator.New()
+	err := v.Struct(options)
+	if err != nil {
+		for _, e := range err.(validator.ValidationErrors) {
+			klog.V(1).Infof("Options validation failed. Err: %v\n", e)
+		}
+		klog.V(6).Infof("export.Write LEAVE\n")
+		return err
+	}
+
+	if options.WordsPerCue <= 0 {
+		options.WordsPerCue = DefaultWordsPerCue
+	}
+	if len(options.Title) == 0 {
+		options.Title = transcript.Title
+	}
+	if len(options.Title) == 0 {
+		options.Title = transcript.ConversationID
+	}
+
+	bw := bufio.NewWriter(w)
+	switch strings.ToLower(options.Format) {
+	case FormatSRT:
+		err = writeSRT(bw, transcript, options)
+	case FormatWebVTT:
+		err = writeWebVTT(bw, transcript, options)
+	case FormatText:
+		err = writeText(bw, transcript, options)
+	case FormatMarkdown:
+		err = writeMarkdown(bw, transcript, options)
+	case FormatHTML:
+		err = writeHTML(bw, transcript, options)
+	case FormatCSV:
+		err = writeCSV(bw, transcript, options)
+	default:
+		klog.V(1).Infof("Unsupported format: %s\n", options.Format)
+		klog.V(6).Infof("export.Write LEAVE\n")
+		return ErrUnsupportedFormat
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		klog.V(1).Infof("Writing %s failed. Err: %v\n", options.Format, err)
+		klog.V(6).Infof("export.Write LEAVE\n")
+		return err
+	}
+
+	klog.V(3).Infof("export.Write Succeeded\n")
+	klog.V(6).Infof("export.Write LEAVE\n")
+	return nil
+}
+
+func writeSRT(w io.Writer, transcript *Transcript, options Options) error {
+	for i, c := range buildCues(transcript, options) {
+		_, err := fmt.Fprintf(w, "%d\n%s --> %s\n%s: %s\n\n", i+1,
+			formatClock(c.start, ","), formatClock(c.end, ","), c.speaker, c.text)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeWebVTT(w io.Writer, transcript *Transcript, options Options) error {
+	_, err := io.WriteString(w, "WEBVTT\n\n")
+	if err != nil {
+		return err
+	}
+
+	for _, c := range buildCues(transcript, options) {
+		speaker := strings.NewReplacer(">", "", "\n", " ").Replace(c.speaker)
+		_, err := fmt.Fprintf(w, "%s --> %s\n<v %s>%s\n\n",
+			formatClock(c.start, "."), formatClock(c.end, "."), speaker, escapeVTT(c.text))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeText(w io.Writer, transcript *Transcript, options Options) error {
+	for _, entry := range transcript.Entries {
+		var err error
+		if options.Timestamps {
+			_, err = fmt.Fprintf(w, "[%s] %s: %s\n", formatTimestamp(entry.Start), entry.Speaker, entry.Text)
+		} else {
+			_, err = fmt.Fprintf(w, "%s: %s\n", entry.Speaker, entry.Text)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeMarkdown(w io.Writer, transcript *Transcript, options Options) error {
+	if len(options.Title) > 0 {
+		_, err := fmt.Fprintf(w, "# %s\n\n", options.Title)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range transcript.Entries {
+		var err error
+		if options.Timestamps {
+			_, err = fmt.Fprintf(w, "**%s** _(%s)_: %s\n\n", entry.Speaker, formatTimestamp(entry.Start), entry.Text)
+		} else {
+			_, err = fmt.Fprintf(w, "**%s**: %s\n\n", entry.Speaker, entry.Text)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeHTML(w io.Writer, transcript *Transcript, options Options) error {
+	title := html.EscapeString(options.Title)
+
+	_, err := fmt.Fprintf(w, "<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n", title)
+	if err != nil {
+		return err
+	}
+	if len(title) > 0 {
+		_, err = fmt.Fprintf(w, "<h1>%s</h1>\n", title)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range transcript.Entries {
+		timestamp := ""
+		if options.Timestamps {
+			timestamp = fmt.Sprintf(" <time>%s</time>", formatTimestamp(entry.Start))
+		}
+		_, err = fmt.Fprintf(w, "<p><strong>%s</strong>%s: %s</p>\n",
+			html.EscapeString(entry.Speaker), timestamp, html.EscapeString(entry.Text))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "</body>\n</html>\n")
+	return err
+}
+
+func writeCSV(w io.Writer, transcript *Transcript, options Options) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write([]string{"message_id", "speaker", "start_seconds", "end_seconds", "text"})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range transcript.Entries {
+		err = cw.Write([]string{
+			entry.MessageID,
+			entry.Speaker,
+			fmt.Sprintf("%.3f", entry.Start.Seconds()),
+			fmt.Sprintf("%.3f", entry.End.Seconds()),
+			entry.Text,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// buildCues uses one cue per message, or groups of words when word timings are requested
+func buildCues(transcript *Transcript, options Options) []cue {
+	cues := make([]cue, 0, len(transcript.Entries))
+
+	for _, entry := range transcript.Entries {
+		if !options.WordTimings || len(entry.Words) == 0 {
+			cues = append(cues, cue{
+				speaker: entry.Speaker,
+				text:    entry.Text,
+				start:   entry.Start,
+				end:     entry.End,
+			})
+			continue
+		}
+
+		for i := 0; i < len(entry.Words); i += options.WordsPerCue {
+			last := i + options.WordsPerCue
+			if last > len(entry.Words) {
+				last = len(entry.Words)
+			}
+			words := entry.Words[i:last]
+
+			text := make([]string, 0, len(words))
+			for _, word := range words {
+				text = append(text, word.Text)
+			}
+
+			cues = append(cues, cue{
+				speaker: entry.Speaker,
+				text:    strings.Join(text, " "),
+				start:   words[0].Start,
+				end:     words[len(words)-1].End,
+			})
+		}
+	}
+
+	return cues
+}
+
+// escapeVTT escapes the characters WebVTT treats as markup
+func escapeVTT(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
+// FromConversation fetches the messages and members of a processed conversation and builds
+// a Transcript from them
+func FromConversation(ctx context.Context, client *async.Client, conversationId string) (*Transcript, error) {
+	klog.V(6).Infof("export.FromConversation ENTER\n")
+
+	// checks
+	if client == nil || len(conversationId) == 0 {
+		klog.V(1).Infof("client or conversationId is empty\n")
+		klog.V(6).Infof("export.FromConversation LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	messageResult, err := client.GetMessages(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetMessages failed. Err: %v\n", err)
+		klog.V(6).Infof("export.FromConversation LEAVE\n")
+		return nil, err
+	}
+
+	// speaker names are best effort
+	var members []interfaces.Member
+	membersResult, err := client.GetMembers(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetMembers failed, using names from messages. Err: %v\n", err)
+	} else {
+		members = membersResult.Members
+	}
+
+	transcript := NewTranscript(messageResult.Messages, members)
+	transcript.ConversationID = conversationId
+
+	klog.V(3).Infof("export.FromConversation Succeeded\n")
+	klog.V(6).Infof("export.FromConversation LEAVE\n")
+	return transcript, nil
+}
+
+// NewTranscript builds a Transcript from messages. Speaker names are taken from the message
+// and, when missing, looked up in members by ID.
+func NewTranscript(messages []interfaces.Message, members []interfaces.Member) *Transcript {
+	names := make(map[string]string)
+	for _, member := range members {
+		if len(member.ID) > 0 && len(member.Name) > 0 {
+			names[member.ID] = member.Name
+		}
+	}
+
+	base := conversationStart(messages)
+
+	transcript := &Transcript{
+		Entries: make([]Entry, 0, len(messages)),
+	}
+	for _, message := range messages {
+		speaker := message.From.Name
+		if len(speaker) == 0 {
+			speaker = names[message.From.ID]
+		}
+		if len(speaker) == 0 {
+			speaker = UnknownSpeaker
+		}
+
+		start, end := resolveTimes(base, message.StartTime, message.EndTime, message.TimeOffset, message.Duration)
+		entry := Entry{
+			MessageID: message.ID,
+			Speaker:   speaker,
+			Text:      strings.TrimSpace(message.Text),
+			Start:     start,
+			End:       end,
+		}
+
+		for _, word := range message.Words {
+			wordStart, wordEnd := resolveTimes(base, word.StartTime, word.EndTime, word.TimeOffset, word.Duration)
+			entry.Words = append(entry.Words, Word{
+				Text:  word.Word,
+				Start: wordStart,
+				End:   wordEnd,
+			})
+		}
+
+		transcript.Entries = append(transcript.Entries, entry)
+	}
+
+	return transcript
+}
+
+// conversationStart is the earliest absolute message start time
+func conversationStart(messages []interfaces.Message) time.Time {
+	var base time.Time
+	for _, message := range messages {
+		if message.StartTime.IsZero() {
+			continue
+		}
+		if base.IsZero() || message.StartTime.Before(base) {
+			base = message.StartTime.Time
+		}
+	}
+	return base
+}
+
+// resolveTimes prefers the offset and duration and falls back to the absolute times
+func resolveTimes(base time.Time, startTime, endTime common.Timestamp, offset, duration common.Seconds) (time.Duration, time.Duration) {
+	if offset > 0 || duration > 0 {
+		return offset.Duration(), offset.Duration() + duration.Duration()
+	}
+	if base.IsZero() {
+		return 0, 0
+	}
+
+	var start, end time.Duration
+	if !startTime.IsZero() {
+		start = startTime.Sub(base)
+	}
+	if !endTime.IsZero() {
+		end = endTime.Sub(base)
+	}
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+// formatClock renders d as HH:MM:SS followed by the separator and milliseconds
+func formatClock(d time.Duration, separator string) string {
+	if d < 0 {
+		d = 0
+	}
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", ms/3600000, (ms/60000)%60, (ms/1000)%60, separator, ms%1000)
+}
+
+// formatTimestamp renders d as HH:MM:SS
+func formatTimestamp(d time.Duration) string {
+	return formatClock(d, ",")[:8]
+}
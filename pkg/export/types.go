@@ -0,0 +1,54 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package export
+
+import (
+	"time"
+)
+
+// Options controls how a Transcript is rendered
+type Options struct {
+	Format string `validate:"required"`
+	Title  string
+
+	// WordTimings splits subtitle cues using the per word timings when available
+	WordTimings bool
+	// WordsPerCue limits the words in a subtitle cue when WordTimings is set, defaults to 12
+	WordsPerCue int
+	// Timestamps adds the start time of each message to the text, Markdown and HTML formats
+	Timestamps bool
+}
+
+// Transcript is a conversation normalized for rendering. Times are relative to the start of
+// the conversation.
+type Transcript struct {
+	ConversationID string
+	Title          string
+	Entries        []Entry
+}
+
+// Entry is a single message in the Transcript
+type Entry struct {
+	MessageID string
+	Speaker   string
+	Text      string
+	Start     time.Duration
+	End       time.Duration
+	Words     []Word
+}
+
+// Word is a single word with its timing
+type Word struct {
+	Text  string
+	Start time.Duration
+	End   time.Duration
+}
+
+// cue is a unit of subtitle output
+type cue struct {
+	speaker string
+	text    string
+	start   time.Duration
+	end     time.Duration
+}
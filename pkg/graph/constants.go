@@ -0,0 +1,30 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package graph
+
+import (
+	"errors"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrMessagesRequired the graph can't be built without the conversation messages
+	ErrMessagesRequired = errors.New("the graph can't be built without the conversation messages")
+
+	// GraphInsights are the insights fetched by Load
+	GraphInsights = []string{
+		async.InsightMessages,
+		async.InsightTopics,
+		async.InsightQuestions,
+		async.InsightActionItems,
+		async.InsightFollowUps,
+		async.InsightSummary,
+		async.InsightTrackers,
+		async.InsightMembers,
+	}
+)
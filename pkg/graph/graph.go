@@ -0,0 +1,340 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package graph
+
+import (
+	"context"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// Load fetches the messages and insights of a conversation once and builds the Graph. Insights
+// other than messages which fail to load are left out of the graph.
+func Load(ctx context.Context, client *async.Client, conversationId string) (*Graph, error) {
+	klog.V(6).Infof("graph.Load ENTER\n")
+
+	// checks
+	if client == nil || len(conversationId) == 0 {
+		klog.V(1).Infof("client or conversationId is empty\n")
+		klog.V(6).Infof("graph.Load LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	insights, err := client.FetchInsights(ctx, conversationId, GraphInsights)
+	if err != nil && err != async.ErrPartialInsights {
+		klog.V(1).Infof("FetchInsights failed. Err: %v\n", err)
+		klog.V(6).Infof("graph.Load LEAVE\n")
+		return nil, err
+	}
+	for insight, fetchErr := range insights.Errors {
+		klog.V(3).Infof("Skipping %s. Err: %v\n", insight, fetchErr)
+	}
+
+	g, err := New(insights)
+	if err != nil {
+		klog.V(1).Infof("New failed. Err: %v\n", err)
+		klog.V(6).Infof("graph.Load LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("graph.Load Succeeded\n")
+	klog.V(6).Infof("graph.Load LEAVE\n")
+	return g, nil
+}
+
+// New builds a Graph from insights which have already been fetched
+func New(insights *async.ConversationInsights) (*Graph, error) {
+	if insights == nil {
+		return nil, ErrInvalidInput
+	}
+	if insights.Messages == nil {
+		return nil, ErrMessagesRequired
+	}
+
+	g := &Graph{
+		ConversationID: insights.ConversationID,
+		messages:       insights.Messages.Messages,
+		messageIndex:   make(map[string]int),
+		members:        make(map[string]interfaces.Member),
+		byMessage:      make(map[string][]int),
+		bySpeaker:      make(map[string][]int),
+	}
+
+	for i, message := range g.messages {
+		g.messageIndex[message.ID] = i
+		if message.StartTime.IsZero() {
+			continue
+		}
+		if g.base.IsZero() || message.StartTime.Before(g.base) {
+			g.base = message.StartTime.Time
+		}
+	}
+	if insights.Members != nil {
+		for _, member := range insights.Members.Members {
+			g.members[member.ID] = member
+		}
+	}
+
+	// insights
+	if insights.Topics != nil {
+		for i := range insights.Topics.Topics {
+			topic := &insights.Topics.Topics[i]
+			g.addInsight(Insight{
+				Type:       async.InsightTopics,
+				Text:       topic.Text,
+				MessageIDs: topic.MessageIds,
+				Topic:      topic,
+			}, interfaces.From{})
+		}
+	}
+	if insights.Questions != nil {
+		for i := range insights.Questions.Questions {
+			question := &insights.Questions.Questions[i]
+			g.addInsight(Insight{
+				Type:       async.InsightQuestions,
+				ID:         question.ID,
+				Text:       question.Text,
+				MessageIDs: question.MessageIds,
+				Question:   question,
+			}, question.From)
+		}
+	}
+	if insights.ActionItems != nil {
+		for i := range insights.ActionItems.ActionItems {
+			actionItem := &insights.ActionItems.ActionItems[i]
+			g.addInsight(Insight{
+				Type:       async.InsightActionItems,
+				ID:         actionItem.ID,
+				Text:       actionItem.Text,
+				MessageIDs: actionItem.MessageIds,
+				ActionItem: actionItem,
+			}, actionItem.From)
+		}
+	}
+	if insights.FollowUps != nil {
+		for i := range insights.FollowUps.FollowUps {
+			followUp := &insights.FollowUps.FollowUps[i]
+			g.addInsight(Insight{
+				Type:       async.InsightFollowUps,
+				ID:         followUp.ID,
+				Text:       followUp.Text,
+				MessageIDs: followUp.MessageIds,
+				FollowUp:   followUp,
+			}, followUp.From)
+		}
+	}
+	if insights.Summary != nil {
+		for i := range insights.Summary.Summaries {
+			summary := &insights.Summary.Summaries[i]
+			messageIds := make([]string, 0, len(summary.MessageRefs))
+			for _, ref := range summary.MessageRefs {
+				messageIds = append(messageIds, ref.ID)
+			}
+			g.addInsight(Insight{
+				Type:       async.InsightSummary,
+				ID:         summary.ID,
+				Text:       summary.Text,
+				MessageIDs: messageIds,
+				Summary:    summary,
+			}, interfaces.From{})
+		}
+	}
+
+	// trackers
+	if insights.Trackers != nil {
+		for _, match := range insights.Trackers.Matches {
+			for _, ref := range match.MessageRefs {
+				hit := TrackerHit{
+					TrackerID:   insights.Trackers.ID,
+					TrackerName: insights.Trackers.Name,
+					Type:        match.Type,
+					Value:       match.Value,
+					MessageRef:  ref,
+				}
+				if message, ok := g.Message(ref.ID); ok {
+					hit.Message = message
+					hit.Start, hit.End = g.MessageTimes(message)
+				} else {
+					hit.Start = g.offset(ref.StartTime.Time)
+					hit.End = g.offset(ref.EndTime.Time)
+				}
+				g.trackerHits = append(g.trackerHits, hit)
+			}
+		}
+	}
+
+	return g, nil
+}
+
+// addInsight indexes the insight by message and by speaker. Speakers come from the insight
+// itself when present and otherwise from the messages it references.
+func (g *Graph) addInsight(insight Insight, from interfaces.From) {
+	index := len(g.insights)
+
+	speakers := make(map[string]bool)
+	if id := speakerID(from); len(id) > 0 {
+		speakers[id] = true
+		insight.SpeakerIDs = append(insight.SpeakerIDs, id)
+	}
+	for _, messageId := range insight.MessageIDs {
+		g.byMessage[messageId] = append(g.byMessage[messageId], index)
+
+		if len(from.ID) > 0 || len(from.Name) > 0 {
+			continue
+		}
+		if message, ok := g.Message(messageId); ok {
+			id := speakerID(message.From)
+			if len(id) > 0 && !speakers[id] {
+				speakers[id] = true
+				insight.SpeakerIDs = append(insight.SpeakerIDs, id)
+			}
+		}
+	}
+	for _, id := range insight.SpeakerIDs {
+		g.bySpeaker[id] = append(g.bySpeaker[id], index)
+	}
+
+	g.insights = append(g.insights, insight)
+}
+
+// Message returns the message with the given ID
+func (g *Graph) Message(messageId string) (*interfaces.Message, bool) {
+	i, ok := g.messageIndex[messageId]
+	if !ok {
+		return nil, false
+	}
+	return &g.messages[i], true
+}
+
+// Messages returns every message in the conversation
+func (g *Graph) Messages() []interfaces.Message {
+	return g.messages
+}
+
+// Insights returns the insights of the given types, or all insights when no type is given
+func (g *Graph) Insights(insightTypes ...string) []Insight {
+	if len(insightTypes) == 0 {
+		return g.insights
+	}
+
+	result := make([]Insight, 0)
+	for _, insight := range g.insights {
+		for _, insightType := range insightTypes {
+			if insight.Type == insightType {
+				result = append(result, insight)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// FindInsight returns the insight of the given type by ID, or by text for insights such as
+// topics which have no ID
+func (g *Graph) FindInsight(insightType, idOrText string) (*Insight, bool) {
+	for i := range g.insights {
+		insight := &g.insights[i]
+		if insight.Type != insightType {
+			continue
+		}
+		if insight.ID == idOrText || (len(insight.ID) == 0 && insight.Text == idOrText) {
+			return insight, true
+		}
+	}
+	return nil, false
+}
+
+// MessagesFor returns the messages referenced by an insight, such as the messages for an
+// action item. References to unknown messages are skipped.
+func (g *Graph) MessagesFor(insight Insight) []interfaces.Message {
+	result := make([]interfaces.Message, 0, len(insight.MessageIDs))
+	for _, messageId := range insight.MessageIDs {
+		if message, ok := g.Message(messageId); ok {
+			result = append(result, *message)
+		}
+	}
+	return result
+}
+
+// InsightsForMessage returns the insights which reference a message
+func (g *Graph) InsightsForMessage(messageId string) []Insight {
+	return g.collect(g.byMessage[messageId])
+}
+
+// InsightsBySpeaker returns the insights raised by a speaker, identified by member ID or by name
+// when the platform provides no ID
+func (g *Graph) InsightsBySpeaker(speakerId string) []Insight {
+	return g.collect(g.bySpeaker[speakerId])
+}
+
+// Speaker returns the member who sent a message, if known
+func (g *Graph) Speaker(message *interfaces.Message) (interfaces.Member, bool) {
+	if message == nil {
+		return interfaces.Member{}, false
+	}
+	if member, ok := g.members[message.From.ID]; ok {
+		return member, true
+	}
+	for _, member := range g.members {
+		if len(message.From.Name) > 0 && member.Name == message.From.Name {
+			return member, true
+		}
+	}
+	return interfaces.Member{}, false
+}
+
+// TrackerHits returns every tracker match in the conversation
+func (g *Graph) TrackerHits() []TrackerHit {
+	return g.trackerHits
+}
+
+// TrackerHitsInRange returns the tracker matches which overlap the time range, relative to the
+// start of the conversation
+func (g *Graph) TrackerHitsInRange(start, end time.Duration) []TrackerHit {
+	result := make([]TrackerHit, 0)
+	for _, hit := range g.trackerHits {
+		hitEnd := hit.End
+		if hitEnd < hit.Start {
+			hitEnd = hit.Start
+		}
+		if hit.Start <= end && hitEnd >= start {
+			result = append(result, hit)
+		}
+	}
+	return result
+}
+
+// MessageTimes returns the start and end of a message relative to the start of the conversation
+func (g *Graph) MessageTimes(message *interfaces.Message) (time.Duration, time.Duration) {
+	if message.TimeOffset > 0 || message.Duration > 0 {
+		return message.TimeOffset.Duration(), message.TimeOffset.Duration() + message.Duration.Duration()
+	}
+	return g.offset(message.StartTime.Time), g.offset(message.EndTime.Time)
+}
+
+func (g *Graph) offset(t time.Time) time.Duration {
+	if t.IsZero() || g.base.IsZero() {
+		return 0
+	}
+	return t.Sub(g.base)
+}
+
+func (g *Graph) collect(indexes []int) []Insight {
+	result := make([]Insight, 0, len(indexes))
+	for _, i := range indexes {
+		result = append(result, g.insights[i])
+	}
+	return result
+}
+
+func speakerID(from interfaces.From) string {
+	if len(from.ID) > 0 {
+		return from.ID
+	}
+	return from.Name
+}
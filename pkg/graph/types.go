@@ -0,0 +1,54 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package graph
+
+import (
+	"time"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// Graph links the insights of a conversation to the messages they reference and the speakers
+// of those messages. It is read only once built and safe for concurrent use.
+type Graph struct {
+	ConversationID string
+
+	messages     []interfaces.Message
+	messageIndex map[string]int
+	members      map[string]interfaces.Member
+	base         time.Time
+
+	insights    []Insight
+	byMessage   map[string][]int
+	bySpeaker   map[string][]int
+	trackerHits []TrackerHit
+}
+
+// Insight is any insight which references messages. Exactly one of the typed fields is set
+// depending on Type, which is one of the async Insight* constants.
+type Insight struct {
+	Type       string
+	ID         string
+	Text       string
+	MessageIDs []string
+	SpeakerIDs []string
+
+	Topic      *interfaces.Topic
+	Question   *interfaces.Question
+	ActionItem *interfaces.ActionItem
+	FollowUp   *interfaces.FollowUp
+	Summary    *interfaces.Summary
+}
+
+// TrackerHit is a single tracker match within a message
+type TrackerHit struct {
+	TrackerID   string
+	TrackerName string
+	Type        string
+	Value       string
+	MessageRef  interfaces.MessageRef
+	Message     *interfaces.Message
+	Start       time.Duration
+	End         time.Duration
+}
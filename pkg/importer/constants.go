@@ -0,0 +1,52 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package importer
+
+import (
+	"errors"
+)
+
+const (
+	// built-in importers
+	FormatSlack string = "slack"
+	FormatEmail string = "email"
+	FormatCSV   string = "csv"
+
+	// default CSV column names, matched case insensitively
+	DefaultCSVSpeakerColumn   string = "speaker"
+	DefaultCSVSpeakerIDColumn string = "speaker_id"
+	DefaultCSVTextColumn      string = "text"
+	DefaultCSVStartColumn     string = "start"
+	DefaultCSVEndColumn       string = "end"
+
+	// slackUsersFile is the user list at the root of a Slack export
+	slackUsersFile string = "users.json"
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrUnknownFormat no importer is registered for the format
+	ErrUnknownFormat = errors.New("no importer is registered for the format")
+
+	// ErrNoMessages the input did not contain any messages
+	ErrNoMessages = errors.New("the input did not contain any messages")
+
+	// ErrMissingColumn a required CSV column was not found in the header
+	ErrMissingColumn = errors.New("a required CSV column was not found in the header")
+
+	// ErrInvalidTimestamp a timestamp could not be parsed
+	ErrInvalidTimestamp = errors.New("a timestamp could not be parsed")
+)
+
+// slackIgnoredSubtypes are channel events rather than conversation
+var slackIgnoredSubtypes = map[string]bool{
+	"channel_join":    true,
+	"channel_leave":   true,
+	"channel_topic":   true,
+	"channel_purpose": true,
+	"channel_name":    true,
+	"pinned_item":     true,
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package importer
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
+// Import implements Importer. Rows with an empty text column are skipped.
+func (c *CSVImporter) Import(r io.Reader) (*interfaces.AsyncTextRequest, error) {
+	klog.V(6).Infof("importer.CSVImporter.Import ENTER\n")
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	if c.Comma != 0 {
+		reader.Comma = c.Comma
+	}
+
+	header, err := reader.Read()
+	if err != nil {
+		klog.V(1).Infof("csv.Read header failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.CSVImporter.Import LEAVE\n")
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	column := func(name, fallback string) int {
+		if len(name) == 0 {
+			name = fallback
+		}
+		if i, ok := columns[strings.ToLower(name)]; ok {
+			return i
+		}
+		return -1
+	}
+
+	speaker := column(c.SpeakerColumn, DefaultCSVSpeakerColumn)
+	speakerID := column(c.SpeakerIDColumn, DefaultCSVSpeakerIDColumn)
+	text := column(c.TextColumn, DefaultCSVTextColumn)
+	start := column(c.StartColumn, DefaultCSVStartColumn)
+	end := column(c.EndColumn, DefaultCSVEndColumn)
+	if text < 0 {
+		klog.V(1).Infof("Text column not found\n")
+		klog.V(6).Infof("importer.CSVImporter.Import LEAVE\n")
+		return nil, ErrMissingColumn
+	}
+
+	var messages []interfaces.TextMessage
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			klog.V(1).Infof("csv.Read failed. Err: %v\n", err)
+			klog.V(6).Infof("importer.CSVImporter.Import LEAVE\n")
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		content := strings.TrimSpace(field(record, text))
+		if len(content) == 0 {
+			continue
+		}
+
+		startTime, err := c.parseTime(field(record, start))
+		if err != nil {
+			klog.V(1).Infof("Invalid start on line %d. Err: %v\n", line, err)
+			klog.V(6).Infof("importer.CSVImporter.Import LEAVE\n")
+			return nil, err
+		}
+		endTime, err := c.parseTime(field(record, end))
+		if err != nil {
+			klog.V(1).Infof("Invalid end on line %d. Err: %v\n", line, err)
+			klog.V(6).Infof("importer.CSVImporter.Import LEAVE\n")
+			return nil, err
+		}
+
+		id := field(record, speakerID)
+		name := field(record, speaker)
+		messages = append(messages, newTextMessage(firstNonEmpty(id, name), firstNonEmpty(name, id), content, startTime, endTime))
+	}
+
+	request, err := newRequest(messages)
+	if err != nil {
+		klog.V(1).Infof("newRequest failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.CSVImporter.Import LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("importer.CSVImporter.Import LEAVE\n")
+	return request, nil
+}
+
+// parseTime accepts Unix seconds or TimeLayout
+func (c *CSVImporter) parseTime(value string) (common.Timestamp, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return common.Timestamp{}, nil
+	}
+
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		whole := int64(seconds)
+		nanos := int64((seconds - float64(whole)) * float64(time.Second))
+		return common.NewTimestamp(time.Unix(whole, nanos).UTC()), nil
+	}
+
+	layout := c.TimeLayout
+	if len(layout) == 0 {
+		layout = time.RFC3339
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return common.Timestamp{}, ErrInvalidTimestamp
+	}
+	return common.NewTimestamp(t), nil
+}
+
+func field(record []string, index int) string {
+	if index < 0 || index >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[index])
+}
@@ -0,0 +1,200 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
+var (
+	emailAttribution = regexp.MustCompile(`(?i)^on .+ wrote:\s*$`)
+	htmlTag          = regexp.MustCompile(`<[^>]*>`)
+)
+
+// Import implements Importer. Each email in the thread becomes one message from its sender.
+func (e *EmailImporter) Import(r io.Reader) (*interfaces.AsyncTextRequest, error) {
+	klog.V(6).Infof("importer.EmailImporter.Import ENTER\n")
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		klog.V(1).Infof("io.ReadAll failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.EmailImporter.Import LEAVE\n")
+		return nil, err
+	}
+
+	var messages []interfaces.TextMessage
+	for _, raw := range splitMbox(data) {
+		msg, err := mail.ReadMessage(bytes.NewReader(raw))
+		if err != nil {
+			klog.V(1).Infof("mail.ReadMessage failed. Err: %v\n", err)
+			klog.V(6).Infof("importer.EmailImporter.Import LEAVE\n")
+			return nil, err
+		}
+
+		body, err := emailBody(msg.Header, msg.Body)
+		if err != nil {
+			klog.V(1).Infof("emailBody failed. Err: %v\n", err)
+			klog.V(6).Infof("importer.EmailImporter.Import LEAVE\n")
+			return nil, err
+		}
+		if !e.KeepQuoted {
+			body = stripQuoted(body)
+		}
+		body = strings.Join(strings.Fields(body), " ")
+		if len(body) == 0 {
+			continue
+		}
+
+		var id, name string
+		if from, err := mail.ParseAddress(msg.Header.Get("From")); err == nil {
+			id = from.Address
+			name = firstNonEmpty(from.Name, from.Address)
+		} else {
+			name = msg.Header.Get("From")
+		}
+
+		var start common.Timestamp
+		if date, err := msg.Header.Date(); err == nil {
+			start = common.NewTimestamp(date.UTC())
+		}
+
+		messages = append(messages, newTextMessage(id, name, body, start, common.Timestamp{}))
+	}
+
+	request, err := newRequest(messages)
+	if err != nil {
+		klog.V(1).Infof("newRequest failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.EmailImporter.Import LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("importer.EmailImporter.Import LEAVE\n")
+	return request, nil
+}
+
+// splitMbox splits an mbox into its messages. Input which doesn't start with an mbox "From "
+// separator is treated as a single message.
+func splitMbox(data []byte) [][]byte {
+	data = bytes.TrimLeft(data, "\r\n")
+	if !bytes.HasPrefix(data, []byte("From ")) {
+		return [][]byte{data}
+	}
+
+	var messages [][]byte
+	var current bytes.Buffer
+	previousBlank := true
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), len(data)+1)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if previousBlank && bytes.HasPrefix(line, []byte("From ")) {
+			if current.Len() > 0 {
+				messages = append(messages, append([]byte(nil), current.Bytes()...))
+				current.Reset()
+			}
+			previousBlank = false
+			continue
+		}
+
+		// mboxrd escapes body lines starting with From
+		if bytes.HasPrefix(line, []byte(">From ")) {
+			line = line[1:]
+		}
+		current.Write(line)
+		current.WriteString("\n")
+		previousBlank = len(bytes.TrimSpace(line)) == 0
+	}
+	if current.Len() > 0 {
+		messages = append(messages, current.Bytes())
+	}
+
+	return messages
+}
+
+// emailBody returns the plain text of a message, preferring text/plain over text/html parts
+func emailBody(header mail.Header, body io.Reader) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	switch strings.ToLower(header.Get("Content-Transfer-Encoding")) {
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		var plain, html string
+
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", err
+			}
+
+			text, err := emailBody(mail.Header(part.Header), part)
+			if err != nil {
+				return "", err
+			}
+			partType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+			if partType == "text/html" {
+				html = firstNonEmpty(html, text)
+			} else if len(plain) == 0 {
+				plain = text
+			}
+		}
+
+		return firstNonEmpty(plain, html), nil
+	}
+
+	if !strings.HasPrefix(mediaType, "text/") {
+		return "", nil
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return "", err
+	}
+	if mediaType == "text/html" {
+		return htmlTag.ReplaceAllString(string(data), " "), nil
+	}
+	return string(data), nil
+}
+
+// stripQuoted removes quoted replies, forwarded originals and the signature
+func stripQuoted(body string) string {
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "--" || strings.HasPrefix(trimmed, "-----Original Message-----") || emailAttribution.MatchString(trimmed) {
+			break
+		}
+		if strings.HasPrefix(trimmed, ">") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package importer
+
+import (
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Importer{
+		FormatSlack: &SlackImporter{},
+		FormatEmail: &EmailImporter{},
+		FormatCSV:   &CSVImporter{},
+	}
+)
+
+// Import implements Importer
+func (f ImporterFunc) Import(r io.Reader) (*interfaces.AsyncTextRequest, error) {
+	return f(r)
+}
+
+// Register makes an importer available to Import under format, replacing any existing one
+func Register(format string, importer Importer) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry[strings.ToLower(format)] = importer
+}
+
+// Lookup returns the importer registered for format
+func Lookup(format string) (Importer, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	importer, ok := registry[strings.ToLower(format)]
+	return importer, ok
+}
+
+// Formats lists the registered formats in sorted order
+func Formats() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	formats := make([]string, 0, len(registry))
+	for format := range registry {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	return formats
+}
+
+// Import parses r with the importer registered for format
+func Import(format string, r io.Reader) (*interfaces.AsyncTextRequest, error) {
+	klog.V(6).Infof("importer.Import ENTER\n")
+
+	importer, ok := Lookup(format)
+	if !ok {
+		klog.V(1).Infof("Unknown format: %s\n", format)
+		klog.V(6).Infof("importer.Import LEAVE\n")
+		return nil, ErrUnknownFormat
+	}
+
+	request, err := importer.Import(r)
+	if err != nil {
+		klog.V(1).Infof("Import %s failed. Err: %v\n", format, err)
+		klog.V(6).Infof("importer.Import LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(4).Infof("Imported %d messages from %s\n", len(request.Messages), format)
+	klog.V(6).Infof("importer.Import LEAVE\n")
+	return request, nil
+}
+
+// ImportFile parses the file at filePath with the importer registered for format
+func ImportFile(format, filePath string) (*interfaces.AsyncTextRequest, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		klog.V(1).Infof("os.Open failed. Err: %v\n", err)
+		return nil, err
+	}
+	defer file.Close()
+
+	return Import(format, file)
+}
+
+// newRequest orders the messages by start time. Untimed messages keep their position after
+// the preceding timed message and ties keep the input order.
+func newRequest(messages []interfaces.TextMessage) (*interfaces.AsyncTextRequest, error) {
+	if len(messages) == 0 {
+		return nil, ErrNoMessages
+	}
+
+	var previous time.Time
+	ordered := make([]timedMessage, 0, len(messages))
+	for _, message := range messages {
+		if message.Duration != nil && !message.Duration.StartTime.IsZero() {
+			previous = message.Duration.StartTime.Time
+		}
+		ordered = append(ordered, timedMessage{start: previous, message: message})
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].start.Before(ordered[j].start)
+	})
+
+	for i := range ordered {
+		messages[i] = ordered[i].message
+	}
+
+	return &interfaces.AsyncTextRequest{
+		Messages: messages,
+	}, nil
+}
+
+// newTextMessage builds a message. Chat messages have no length so the end time defaults to
+// the start time.
+func newTextMessage(id, name, content string, start, end common.Timestamp) interfaces.TextMessage {
+	message := interfaces.TextMessage{
+		Payload: interfaces.Payload{
+			Content: content,
+		},
+	}
+	if len(id) > 0 || len(name) > 0 {
+		message.From = &interfaces.From{
+			ID:   id,
+			Name: name,
+		}
+	}
+	if !start.IsZero() {
+		if end.IsZero() || end.Before(start.Time) {
+			end = start
+		}
+		message.Duration = &interfaces.Duration{
+			StartTime: start,
+			EndTime:   end,
+		}
+	}
+	return message
+}
@@ -0,0 +1,232 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package importer
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	common "github.com/dvonthenen/symbl-go-sdk/pkg/api/common"
+)
+
+var slackMention = regexp.MustCompile(`<@([A-Z0-9]+)(\|[^>]*)?>`)
+
+// Import implements Importer for the messages of a single day of a channel export
+func (s *SlackImporter) Import(r io.Reader) (*interfaces.AsyncTextRequest, error) {
+	klog.V(6).Infof("importer.SlackImporter.Import ENTER\n")
+
+	var slackMessages []slackMessage
+	err := json.NewDecoder(r).Decode(&slackMessages)
+	if err != nil {
+		klog.V(1).Infof("json.Decode failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.SlackImporter.Import LEAVE\n")
+		return nil, err
+	}
+
+	messages, err := s.convert(slackMessages)
+	if err != nil {
+		klog.V(1).Infof("convert failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.SlackImporter.Import LEAVE\n")
+		return nil, err
+	}
+
+	request, err := newRequest(messages)
+	if err != nil {
+		klog.V(1).Infof("newRequest failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.SlackImporter.Import LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("importer.SlackImporter.Import LEAVE\n")
+	return request, nil
+}
+
+// ImportSlackChannel reads an entire channel from an unzipped Slack export. Speaker names are
+// taken from users.json at the root of the export when present and Users is empty.
+func ImportSlackChannel(exportDir, channel string, options SlackImporter) (*interfaces.AsyncTextRequest, error) {
+	klog.V(6).Infof("importer.ImportSlackChannel ENTER\n")
+
+	// checks
+	if len(exportDir) == 0 || len(channel) == 0 {
+		klog.V(1).Infof("exportDir or channel is empty\n")
+		klog.V(6).Infof("importer.ImportSlackChannel LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	if len(options.Users) == 0 {
+		users, err := LoadSlackUsers(filepath.Join(exportDir, slackUsersFile))
+		if err != nil && !os.IsNotExist(err) {
+			klog.V(1).Infof("LoadSlackUsers failed. Err: %v\n", err)
+			klog.V(6).Infof("importer.ImportSlackChannel LEAVE\n")
+			return nil, err
+		}
+		options.Users = users
+	}
+
+	// one file per day, named YYYY-MM-DD.json
+	files, err := filepath.Glob(filepath.Join(exportDir, channel, "*.json"))
+	if err != nil {
+		klog.V(1).Infof("filepath.Glob failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.ImportSlackChannel LEAVE\n")
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var messages []interfaces.TextMessage
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			klog.V(1).Infof("os.ReadFile failed. Err: %v\n", err)
+			klog.V(6).Infof("importer.ImportSlackChannel LEAVE\n")
+			return nil, err
+		}
+
+		var slackMessages []slackMessage
+		err = json.Unmarshal(data, &slackMessages)
+		if err != nil {
+			klog.V(1).Infof("json.Unmarshal %s failed. Err: %v\n", file, err)
+			klog.V(6).Infof("importer.ImportSlackChannel LEAVE\n")
+			return nil, err
+		}
+
+		converted, err := options.convert(slackMessages)
+		if err != nil {
+			klog.V(1).Infof("convert %s failed. Err: %v\n", file, err)
+			klog.V(6).Infof("importer.ImportSlackChannel LEAVE\n")
+			return nil, err
+		}
+		messages = append(messages, converted...)
+	}
+
+	request, err := newRequest(messages)
+	if err != nil {
+		klog.V(1).Infof("newRequest failed. Err: %v\n", err)
+		klog.V(6).Infof("importer.ImportSlackChannel LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(4).Infof("Imported %d messages from %d files\n", len(request.Messages), len(files))
+	klog.V(6).Infof("importer.ImportSlackChannel LEAVE\n")
+	return request, nil
+}
+
+// LoadSlackUsers reads users.json from a Slack export into a map of user ID to display name
+func LoadSlackUsers(filePath string) (map[string]string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var slackUsers []slackUser
+	err = json.Unmarshal(data, &slackUsers)
+	if err != nil {
+		klog.V(1).Infof("json.Unmarshal failed. Err: %v\n", err)
+		return nil, err
+	}
+
+	users := make(map[string]string)
+	for _, user := range slackUsers {
+		users[user.ID] = firstNonEmpty(user.Profile.DisplayName, user.Profile.RealName, user.RealName, user.Name)
+	}
+	return users, nil
+}
+
+func (s *SlackImporter) convert(slackMessages []slackMessage) ([]interfaces.TextMessage, error) {
+	var messages []interfaces.TextMessage
+
+	for _, m := range slackMessages {
+		if m.Type != "message" || slackIgnoredSubtypes[m.Subtype] {
+			continue
+		}
+		if !s.IncludeBots && (len(m.BotID) > 0 || m.Subtype == "bot_message") {
+			continue
+		}
+
+		text := strings.TrimSpace(s.replaceMentions(m.Text))
+		if len(text) == 0 {
+			continue
+		}
+
+		start, err := parseSlackTs(m.Ts)
+		if err != nil {
+			return nil, err
+		}
+
+		id := m.User
+		if len(id) == 0 {
+			id = m.BotID
+		}
+		name := s.Users[m.User]
+		if len(name) == 0 && m.UserProfile != nil {
+			name = firstNonEmpty(m.UserProfile.DisplayName, m.UserProfile.RealName)
+		}
+		if len(name) == 0 {
+			name = firstNonEmpty(m.Username, id)
+		}
+
+		messages = append(messages, newTextMessage(id, name, text, start, common.Timestamp{}))
+	}
+
+	return messages, nil
+}
+
+// replaceMentions rewrites <@U123> as @name
+func (s *SlackImporter) replaceMentions(text string) string {
+	return slackMention.ReplaceAllStringFunc(text, func(mention string) string {
+		match := slackMention.FindStringSubmatch(mention)
+		if name, ok := s.Users[match[1]]; ok && len(name) > 0 {
+			return "@" + name
+		}
+		if len(match[2]) > 1 {
+			return "@" + match[2][1:]
+		}
+		return "@" + match[1]
+	})
+}
+
+// parseSlackTs converts a Slack message ts of the form "1355517523.000005"
+func parseSlackTs(ts string) (common.Timestamp, error) {
+	if len(ts) == 0 {
+		return common.Timestamp{}, nil
+	}
+
+	whole, fraction, _ := strings.Cut(ts, ".")
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		klog.V(1).Infof("Invalid Slack ts: %s\n", ts)
+		return common.Timestamp{}, ErrInvalidTimestamp
+	}
+
+	// fraction is microseconds, padded or truncated to nanoseconds
+	var nanos int64
+	if len(fraction) > 0 {
+		fraction = (fraction + "000000000")[:9]
+		nanos, err = strconv.ParseInt(fraction, 10, 64)
+		if err != nil {
+			klog.V(1).Infof("Invalid Slack ts: %s\n", ts)
+			return common.Timestamp{}, ErrInvalidTimestamp
+		}
+	}
+
+	return common.NewTimestamp(time.Unix(seconds, nanos).UTC()), nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if len(value) > 0 {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package importer
+
+import (
+	"io"
+	"time"
+
+	interfaces "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// Importer parses a chat transcript into the messages of an AsyncTextRequest. Only Messages is
+// filled in; the remaining request options are left to the caller.
+type Importer interface {
+	Import(r io.Reader) (*interfaces.AsyncTextRequest, error)
+}
+
+// ImporterFunc adapts a function to the Importer interface
+type ImporterFunc func(r io.Reader) (*interfaces.AsyncTextRequest, error)
+
+// SlackImporter parses the JSON message array of a single day of a Slack channel export
+type SlackImporter struct {
+	// Users maps Slack user IDs to display names. It is used for speakers and to rewrite
+	// <@U123> mentions. Use LoadSlackUsers to read users.json from an export.
+	Users map[string]string
+	// IncludeBots keeps messages posted by bots and integrations
+	IncludeBots bool
+}
+
+// EmailImporter parses an email thread stored as a single RFC 5322 message or as an mbox
+type EmailImporter struct {
+	// KeepQuoted keeps quoted replies and "On ... wrote:" attributions in the message body
+	KeepQuoted bool
+}
+
+// CSVImporter parses a chat log with a header row. Column names default to the DefaultCSV
+// constants. Only the text column is required.
+type CSVImporter struct {
+	SpeakerColumn   string
+	SpeakerIDColumn string
+	TextColumn      string
+	StartColumn     string
+	EndColumn       string
+
+	// TimeLayout parses the start and end columns, defaults to RFC3339. Values which are plain
+	// numbers are always treated as Unix seconds.
+	TimeLayout string
+	// Comma separates fields, defaults to ','
+	Comma rune
+}
+
+// slackMessage is a message from a Slack channel export
+type slackMessage struct {
+	Type        string `json:"type"`
+	Subtype     string `json:"subtype,omitempty"`
+	User        string `json:"user,omitempty"`
+	BotID       string `json:"bot_id,omitempty"`
+	Username    string `json:"username,omitempty"`
+	Text        string `json:"text"`
+	Ts          string `json:"ts"`
+	UserProfile *struct {
+		RealName    string `json:"real_name,omitempty"`
+		DisplayName string `json:"display_name,omitempty"`
+	} `json:"user_profile,omitempty"`
+}
+
+// slackUser is an entry of users.json in a Slack export
+type slackUser struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	RealName string `json:"real_name,omitempty"`
+	Profile  struct {
+		RealName    string `json:"real_name,omitempty"`
+		DisplayName string `json:"display_name,omitempty"`
+	} `json:"profile"`
+}
+
+// timedMessage is a message with the start time it is ordered by
+type timedMessage struct {
+	start   time.Time
+	message interfaces.TextMessage
+}
@@ -0,0 +1,34 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"errors"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+)
+
+const (
+	// DocumentExtension is used for the per conversation files in the index directory
+	DocumentExtension string = ".json"
+
+	// DefaultLimit on the number of conversations returned by Search
+	DefaultLimit int = 50
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrNotFound the conversation is not in the index
+	ErrNotFound = errors.New("the conversation is not in the index")
+
+	// IndexedInsights are fetched by AddConversation
+	IndexedInsights = []string{
+		async.InsightMessages,
+		async.InsightTrackers,
+		async.InsightEntities,
+		async.InsightTopics,
+	}
+)
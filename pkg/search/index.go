@@ -0,0 +1,266 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	klog "k8s.io/klog/v2"
+
+	async "github.com/dvonthenen/symbl-go-sdk/pkg/api/async/v1"
+	atomicfile "github.com/dvonthenen/symbl-go-sdk/pkg/internal/atomicfile"
+)
+
+// Open loads the index stored in dir, creating the directory if needed
+func Open(dir string) (*Index, error) {
+	klog.V(6).Infof("search.Open ENTER\n")
+
+	// checks
+	if len(dir) == 0 {
+		klog.V(1).Infof("dir is empty\n")
+		klog.V(6).Infof("search.Open LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		klog.V(1).Infof("os.MkdirAll failed. Err: %v\n", err)
+		klog.V(6).Infof("search.Open LEAVE\n")
+		return nil, err
+	}
+
+	ix := &Index{
+		dir:   dir,
+		docs:  make(map[string]*Document),
+		terms: make(map[string]map[string]bool),
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*"+DocumentExtension))
+	if err != nil {
+		klog.V(1).Infof("filepath.Glob failed. Err: %v\n", err)
+		klog.V(6).Infof("search.Open LEAVE\n")
+		return nil, err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			klog.V(1).Infof("os.ReadFile failed. Err: %v\n", err)
+			klog.V(6).Infof("search.Open LEAVE\n")
+			return nil, err
+		}
+
+		var doc Document
+		err = json.Unmarshal(data, &doc)
+		if err != nil {
+			klog.V(1).Infof("Skipping corrupt document %s. Err: %v\n", file, err)
+			continue
+		}
+		ix.index(&doc)
+	}
+
+	klog.V(3).Infof("Opened index with %d conversations\n", len(ix.docs))
+	klog.V(6).Infof("search.Open LEAVE\n")
+	return ix, nil
+}
+
+// AddConversation fetches the messages, trackers, entities and topics of a conversation and
+// adds them to the index, replacing any previous version
+func (ix *Index) AddConversation(ctx context.Context, client *async.Client, conversationId string) error {
+	klog.V(6).Infof("search.AddConversation ENTER\n")
+
+	// checks
+	if client == nil || len(conversationId) == 0 {
+		klog.V(1).Infof("client or conversationId is empty\n")
+		klog.V(6).Infof("search.AddConversation LEAVE\n")
+		return ErrInvalidInput
+	}
+
+	insights, err := client.FetchInsights(ctx, conversationId, IndexedInsights)
+	if err != nil && err != async.ErrPartialInsights {
+		klog.V(1).Infof("FetchInsights failed. Err: %v\n", err)
+		klog.V(6).Infof("search.AddConversation LEAVE\n")
+		return err
+	}
+	if insights.Messages == nil {
+		klog.V(1).Infof("Messages could not be fetched. Err: %v\n", insights.Errors[async.InsightMessages])
+		klog.V(6).Infof("search.AddConversation LEAVE\n")
+		return insights.Errors[async.InsightMessages]
+	}
+
+	doc := NewDocument(insights)
+
+	// name and date are best effort
+	conversation, err := client.GetConversation(ctx, conversationId)
+	if err != nil {
+		klog.V(3).Infof("GetConversation failed. Err: %v\n", err)
+	} else {
+		doc.Name = conversation.Name
+		if !conversation.StartTime.IsZero() {
+			doc.StartTime = conversation.StartTime.Time
+		}
+	}
+
+	err = ix.Add(doc)
+	if err != nil {
+		klog.V(1).Infof("Add failed. Err: %v\n", err)
+		klog.V(6).Infof("search.AddConversation LEAVE\n")
+		return err
+	}
+
+	klog.V(3).Infof("search.AddConversation Succeeded\n")
+	klog.V(6).Infof("search.AddConversation LEAVE\n")
+	return nil
+}
+
+// NewDocument converts fetched insights into a Document
+func NewDocument(insights *async.ConversationInsights) Document {
+	doc := Document{
+		ConversationID: insights.ConversationID,
+	}
+
+	if insights.Messages != nil {
+		for _, message := range insights.Messages.Messages {
+			doc.Messages = append(doc.Messages, Message{
+				ID:          message.ID,
+				SpeakerID:   message.From.ID,
+				SpeakerName: message.From.Name,
+				Text:        message.Text,
+				StartTime:   message.StartTime.Time,
+			})
+			if !message.StartTime.IsZero() && (doc.StartTime.IsZero() || message.StartTime.Before(doc.StartTime)) {
+				doc.StartTime = message.StartTime.Time
+			}
+		}
+	}
+	if insights.Trackers != nil && len(insights.Trackers.Matches) > 0 {
+		doc.Trackers = append(doc.Trackers, insights.Trackers.Name)
+	}
+	if insights.Entities != nil {
+		for _, entity := range insights.Entities.Entities {
+			for _, match := range entity.Matches {
+				doc.Entities = append(doc.Entities, Entity{
+					Type:     entity.Type,
+					SubType:  entity.SubType,
+					Category: entity.Category,
+					Value:    match.DetectedValue,
+				})
+			}
+		}
+	}
+	if insights.Topics != nil {
+		for _, topic := range insights.Topics.Topics {
+			doc.Topics = append(doc.Topics, topic.Text)
+		}
+	}
+
+	return doc
+}
+
+// Add stores the document in the index directory and indexes it, replacing any previous version
+func (ix *Index) Add(doc Document) error {
+	if len(doc.ConversationID) == 0 {
+		return ErrInvalidInput
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	err = atomicfile.WriteFile(ix.path(doc.ConversationID), data)
+	if err != nil {
+		return err
+	}
+
+	ix.unindex(doc.ConversationID)
+	ix.index(&doc)
+	return nil
+}
+
+// Remove deletes a conversation from the index
+func (ix *Index) Remove(conversationId string) error {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if _, ok := ix.docs[conversationId]; !ok {
+		return ErrNotFound
+	}
+
+	err := os.Remove(ix.path(conversationId))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	ix.unindex(conversationId)
+	return nil
+}
+
+// Get returns the indexed document for a conversation
+func (ix *Index) Get(conversationId string) (*Document, bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	doc, ok := ix.docs[conversationId]
+	return doc, ok
+}
+
+// Len is the number of conversations in the index
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	return len(ix.docs)
+}
+
+// index the caller must hold ix.mu
+func (ix *Index) index(doc *Document) {
+	ix.docs[doc.ConversationID] = doc
+
+	for _, message := range doc.Messages {
+		for _, term := range tokenize(message.Text) {
+			if ix.terms[term] == nil {
+				ix.terms[term] = make(map[string]bool)
+			}
+			ix.terms[term][doc.ConversationID] = true
+		}
+	}
+}
+
+// unindex the caller must hold ix.mu
+func (ix *Index) unindex(conversationId string) {
+	doc, ok := ix.docs[conversationId]
+	if !ok {
+		return
+	}
+
+	for _, message := range doc.Messages {
+		for _, term := range tokenize(message.Text) {
+			delete(ix.terms[term], conversationId)
+			if len(ix.terms[term]) == 0 {
+				delete(ix.terms, term)
+			}
+		}
+	}
+	delete(ix.docs, conversationId)
+}
+
+func (ix *Index) path(conversationId string) string {
+	return filepath.Join(ix.dir, filepath.Base(conversationId)+DocumentExtension)
+}
+
+// tokenize lower cases text and splits it into words
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"sort"
+	"strings"
+)
+
+// Search returns the conversations matching every criteria set in query, best matches first,
+// along with facet counts over all of the matching conversations
+func (ix *Index) Search(query Query) *Results {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	if query.Limit <= 0 {
+		query.Limit = DefaultLimit
+	}
+	keywords := tokenize(query.Keywords)
+
+	results := &Results{
+		Facets: Facets{
+			Speakers:    make(map[string]int),
+			Trackers:    make(map[string]int),
+			EntityTypes: make(map[string]int),
+			Topics:      make(map[string]int),
+		},
+	}
+
+	for _, id := range ix.candidates(keywords) {
+		doc := ix.docs[id]
+		result, ok := match(doc, query, keywords)
+		if !ok {
+			continue
+		}
+
+		results.Total++
+		results.Results = append(results.Results, *result)
+		addFacets(&results.Facets, doc)
+	}
+
+	sort.SliceStable(results.Results, func(i, j int) bool {
+		if results.Results[i].Score != results.Results[j].Score {
+			return results.Results[i].Score > results.Results[j].Score
+		}
+		return results.Results[i].StartTime.After(results.Results[j].StartTime)
+	})
+	if len(results.Results) > query.Limit {
+		results.Results = results.Results[:query.Limit]
+	}
+
+	return results
+}
+
+// candidates narrows the documents using the inverted index. The caller must hold ix.mu.
+func (ix *Index) candidates(keywords []string) []string {
+	ids := make([]string, 0)
+	if len(keywords) == 0 {
+		for id := range ix.docs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+
+	for id := range ix.terms[keywords[0]] {
+		found := true
+		for _, term := range keywords[1:] {
+			if !ix.terms[term][id] {
+				found = false
+				break
+			}
+		}
+		if found {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func match(doc *Document, query Query, keywords []string) (*Result, bool) {
+	if !query.From.IsZero() && doc.StartTime.Before(query.From) {
+		return nil, false
+	}
+	if !query.To.IsZero() && doc.StartTime.After(query.To) {
+		return nil, false
+	}
+
+	result := &Result{
+		ConversationID: doc.ConversationID,
+		Name:           doc.Name,
+		StartTime:      doc.StartTime,
+	}
+
+	if len(query.Tracker) > 0 {
+		if !containsFold(doc.Trackers, query.Tracker) {
+			return nil, false
+		}
+		result.Score++
+	}
+	if len(query.Topic) > 0 {
+		if !containsFold(doc.Topics, query.Topic) {
+			return nil, false
+		}
+		result.Score++
+	}
+	if len(query.EntityType) > 0 || len(query.EntityValue) > 0 {
+		found := false
+		for _, entity := range doc.Entities {
+			typeMatches := len(query.EntityType) == 0 ||
+				strings.EqualFold(entity.Type, query.EntityType) || strings.EqualFold(entity.SubType, query.EntityType)
+			valueMatches := len(query.EntityValue) == 0 || strings.EqualFold(entity.Value, query.EntityValue)
+			if typeMatches && valueMatches {
+				found = true
+				result.Score++
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+
+	// message level criteria
+	if len(keywords) == 0 && len(query.Speaker) == 0 {
+		return result, true
+	}
+	for _, message := range doc.Messages {
+		if len(query.Speaker) > 0 && !strings.EqualFold(message.SpeakerID, query.Speaker) &&
+			!strings.EqualFold(message.SpeakerName, query.Speaker) {
+			continue
+		}
+
+		hits := 0
+		if len(keywords) > 0 {
+			counts := make(map[string]int)
+			for _, term := range tokenize(message.Text) {
+				counts[term]++
+			}
+			for _, keyword := range keywords {
+				if counts[keyword] == 0 {
+					hits = 0
+					break
+				}
+				hits += counts[keyword]
+			}
+			if hits == 0 {
+				continue
+			}
+		}
+
+		result.Messages = append(result.Messages, message)
+		result.Score += float64(hits)
+	}
+	if len(result.Messages) == 0 {
+		return nil, false
+	}
+
+	return result, true
+}
+
+func addFacets(facets *Facets, doc *Document) {
+	speakers := make(map[string]bool)
+	for _, message := range doc.Messages {
+		speaker := message.SpeakerName
+		if len(speaker) == 0 {
+			speaker = message.SpeakerID
+		}
+		if len(speaker) > 0 {
+			speakers[speaker] = true
+		}
+	}
+	countOnce(facets.Speakers, keys(speakers))
+	countOnce(facets.Trackers, doc.Trackers)
+	countOnce(facets.Topics, doc.Topics)
+
+	entityTypes := make([]string, 0, len(doc.Entities))
+	for _, entity := range doc.Entities {
+		entityTypes = append(entityTypes, entity.Type)
+	}
+	countOnce(facets.EntityTypes, entityTypes)
+}
+
+// countOnce increments each distinct value once
+func countOnce(counts map[string]int, values []string) {
+	seen := make(map[string]bool)
+	for _, value := range values {
+		if len(value) == 0 || seen[value] {
+			continue
+		}
+		seen[value] = true
+		counts[value]++
+	}
+}
+
+func keys(m map[string]bool) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	return result
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
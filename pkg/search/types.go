@@ -0,0 +1,91 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// SPDX-License-Identifier: MIT
+
+package search
+
+import (
+	"sync"
+	"time"
+)
+
+// Index is a local full text and faceted index of conversations. Each conversation is stored
+// as a JSON document in the index directory and the in memory index is rebuilt by Open.
+type Index struct {
+	dir string
+
+	mu    sync.RWMutex
+	docs  map[string]*Document
+	terms map[string]map[string]bool // term -> conversationId
+}
+
+// Document is the indexed form of a conversation
+type Document struct {
+	ConversationID string    `json:"conversationId"`
+	Name           string    `json:"name,omitempty"`
+	StartTime      time.Time `json:"startTime,omitempty"`
+	Messages       []Message `json:"messages,omitempty"`
+	Trackers       []string  `json:"trackers,omitempty"`
+	Entities       []Entity  `json:"entities,omitempty"`
+	Topics         []string  `json:"topics,omitempty"`
+}
+
+// Message is an indexed message
+type Message struct {
+	ID          string    `json:"id"`
+	SpeakerID   string    `json:"speakerId,omitempty"`
+	SpeakerName string    `json:"speakerName,omitempty"`
+	Text        string    `json:"text"`
+	StartTime   time.Time `json:"startTime,omitempty"`
+}
+
+// Entity is an indexed entity detection
+type Entity struct {
+	Type     string `json:"type"`
+	SubType  string `json:"subType,omitempty"`
+	Category string `json:"category,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+// Query selects conversations. All of the criteria which are set must match.
+type Query struct {
+	// Keywords must all appear in a single message
+	Keywords string
+	// Speaker matches the speaker ID or name of a message, case insensitive
+	Speaker string
+	Tracker string
+	// EntityType matches the entity type or sub type, EntityValue the detected value
+	EntityType  string
+	EntityValue string
+	Topic       string
+
+	// From and To restrict the conversation start time
+	From time.Time
+	To   time.Time
+
+	// Limit the number of results, defaults to 50
+	Limit int
+}
+
+// Results of a Search
+type Results struct {
+	Total   int
+	Results []Result
+	Facets  Facets
+}
+
+// Result is a matching conversation
+type Result struct {
+	ConversationID string
+	Name           string
+	StartTime      time.Time
+	Score          float64
+	Messages       []Message
+}
+
+// Facets count the matching conversations by each value
+type Facets struct {
+	Speakers    map[string]int
+	Trackers    map[string]int
+	EntityTypes map[string]int
+	Topics      map[string]int
+}